@@ -0,0 +1,4 @@
+data "ziti_auth_policy" "test_reference_ziti_auth_policy" {
+  most_recent = true
+  filter      = "name contains \"test\""
+}
@@ -0,0 +1,3 @@
+data "ziti_auth_policy_ids" "test_reference_ziti_auth_policy_ids" {
+  filter = "name contains \"test\""
+}
@@ -0,0 +1,7 @@
+data "ziti_config" "test_reference_ziti_config" {
+  name = "test_config"
+}
+
+output "test_config_endpoint" {
+  value = data.ziti_config.test_reference_ziti_config.data.endpoint
+}
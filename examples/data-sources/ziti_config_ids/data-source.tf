@@ -0,0 +1,3 @@
+data "ziti_config_ids" "test_reference_ziti_config_ids" {
+  filter = "name contains \"test\""
+}
@@ -0,0 +1,3 @@
+data "ziti_config_type" "test_reference_ziti_config_type" {
+  name = "host.v1"
+}
@@ -0,0 +1,4 @@
+data "ziti_edge_router" "test_reference_ziti_edge_router" {
+  most_recent = true
+  filter      = "name contains \"test\""
+}
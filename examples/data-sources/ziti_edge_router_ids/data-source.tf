@@ -0,0 +1,3 @@
+data "ziti_edge_router_ids" "test_reference_ziti_edge_router_ids" {
+  filter = "name contains \"test\""
+}
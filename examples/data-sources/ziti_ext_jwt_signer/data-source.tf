@@ -0,0 +1,4 @@
+data "ziti_ext_jwt_signer" "test_reference_ziti_ext_jwt_signer" {
+  most_recent = true
+  filter      = "name contains \"test\""
+}
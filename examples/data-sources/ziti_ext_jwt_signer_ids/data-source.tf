@@ -0,0 +1,3 @@
+data "ziti_ext_jwt_signer_ids" "test_reference_ziti_ext_jwt_signer_ids" {
+  filter = "name contains \"test\""
+}
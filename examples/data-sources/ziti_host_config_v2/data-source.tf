@@ -0,0 +1,12 @@
+data "ziti_host_config_v2" "example_reference_by_filter" {
+  most_recent = true
+  filter      = "name contains \"v2\""
+}
+
+data "ziti_host_config_v2" "example_reference_by_name" {
+  name = "active_standby.host.v2"
+}
+
+data "ziti_host_config_v2" "example_reference_by_id" {
+  id = "4k50QRBgdJqtNE3YhyuteV"
+}
@@ -0,0 +1,3 @@
+data "ziti_host_config_v2_ids" "test_config_ids" {
+  filter = "name contains \"v2\""
+}
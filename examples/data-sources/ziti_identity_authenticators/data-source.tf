@@ -0,0 +1,4 @@
+data "ziti_identity_authenticators" "break_glass" {
+  identity_id = ziti_identity.break_glass.id
+  filter      = "method = \"updb\""
+}
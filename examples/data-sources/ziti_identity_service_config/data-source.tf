@@ -0,0 +1,4 @@
+data "ziti_identity_service_config" "site_a_gateway_db" {
+  identity_id = ziti_identity.site_a_gateway.id
+  service_id  = ziti_service.db.id
+}
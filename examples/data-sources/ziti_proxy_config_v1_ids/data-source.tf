@@ -0,0 +1,3 @@
+data "ziti_proxy_config_v1_ids" "test_config_ids" {
+  filter = "name contains \"proxy.v1\""
+}
@@ -0,0 +1,4 @@
+data "ziti_terminators" "test_reference_ziti_terminators" {
+  service_id = ziti_service.test_service.id
+  filter     = "precedence = \"default\""
+}
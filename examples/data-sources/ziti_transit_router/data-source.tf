@@ -0,0 +1,4 @@
+data "ziti_transit_router" "test_reference_ziti_transit_router" {
+  most_recent = true
+  filter      = "name contains \"test\""
+}
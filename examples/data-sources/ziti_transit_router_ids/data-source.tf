@@ -0,0 +1,3 @@
+data "ziti_transit_router_ids" "test_reference_ziti_transit_router_ids" {
+  filter = "name contains \"test\""
+}
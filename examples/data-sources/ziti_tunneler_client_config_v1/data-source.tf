@@ -0,0 +1,12 @@
+data "ziti_tunneler_client_config_v1" "example_reference_by_filter" {
+  most_recent = true
+  filter      = "name contains \"ziti-tunneler-client\""
+}
+
+data "ziti_tunneler_client_config_v1" "example_reference_by_name" {
+  name = "postgres.ziti-tunneler-client.v1"
+}
+
+data "ziti_tunneler_client_config_v1" "example_reference_by_id" {
+  id = "4k50QRBgdJqtNE3YhyuteV"
+}
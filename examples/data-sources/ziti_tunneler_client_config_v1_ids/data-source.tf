@@ -0,0 +1,3 @@
+data "ziti_tunneler_client_config_v1_ids" "test_config_ids" {
+  filter = "name contains \"ziti-tunneler-client\""
+}
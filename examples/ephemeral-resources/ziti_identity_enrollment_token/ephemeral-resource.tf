@@ -0,0 +1,19 @@
+resource "ziti_identity" "router" {
+  name = "router"
+}
+
+# The JWT is never stored in the plan or the state, it is only available
+# to other ephemeral contexts (eg provider blocks) during the run.
+ephemeral "ziti_identity_enrollment_token" "router" {
+  identity_id = ziti_identity.router.id
+  validity    = "1h"
+}
+
+provider "vault" {}
+
+resource "vault_kv_secret_v2" "router_enrollment" {
+  mount                = "secret"
+  name                 = "ziti/router/enrollment"
+  data_json_wo         = jsonencode({ jwt = ephemeral.ziti_identity_enrollment_token.router.jwt })
+  data_json_wo_version = 1
+}
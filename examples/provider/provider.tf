@@ -1,5 +1,61 @@
+# Retrieves the CA pool from the controller and trusts it without verification, eg for a local quickstart network.
 provider "ziti" {
-  username      = "testadmin"
-  password      = "testadmin"
+  username            = "testadmin"
+  password            = "testadmin"
+  mgmt_endpoint       = "https://localhost:1280/edge/management/v1"
+  trust_well_known_ca = true
+}
+
+# Authenticates with a client certificate of an admin identity instead of a password.
+provider "ziti" {
+  alias         = "cert"
+  mgmt_endpoint = "https://localhost:1280/edge/management/v1"
+  capool_file   = "ca.pem"
+  cert_file     = "admin.cert"
+  key_file      = "admin.key"
+}
+
+# Authenticates through an external JWT signer with an OIDC token of a CI runner.
+# The file is read again whenever the runner rotates the token.
+provider "ziti" {
+  alias         = "ci"
+  mgmt_endpoint = "https://localhost:1280/edge/management/v1"
+  capool_file   = "ca.pem"
+  jwt_file      = "/var/run/secrets/ziti/token"
+}
+
+# Derives the management endpoint, the CA pool and the client certificate from an identity file.
+provider "ziti" {
+  alias         = "identity"
+  identity_file = "admin.json"
+}
+
+# Answers the TOTP challenge of an auth policy that requires MFA.
+# The password and the base32 TOTP secret are taken from the ZITI_EDGE_MGMT_PASSWORD and ZITI_EDGE_MGMT_TOTP_SECRET
+# environment variables, to keep them out of the configuration.
+provider "ziti" {
+  alias         = "mfa"
+  username      = "terraform"
   mgmt_endpoint = "https://localhost:1280/edge/management/v1"
+  capool_file   = "ca.pem"
+}
+
+# Sends requests to the controllers of a HA cluster in a random order, failing over when one cannot be reached,
+# and retries requests which fail while the cluster is unavailable.
+provider "ziti" {
+  alias = "cluster"
+  mgmt_endpoints = [
+    "https://ctrl1.example.com:1280/edge/management/v1",
+    "https://ctrl2.example.com:1280/edge/management/v1",
+    "https://ctrl3.example.com:1280/edge/management/v1",
+  ]
+  mgmt_endpoints_order = "random"
+  capool_file          = "ca.pem"
+  cert_file            = "admin.cert"
+  key_file             = "admin.key"
+
+  # Rides out controller restarts and raft leader elections.
+  max_retries       = 5
+  retry_min_backoff = "2s"
+  retry_max_backoff = "1m"
 }
@@ -0,0 +1,27 @@
+resource "ziti_auth_policy" "test_auth_policy" {
+  name = "test_auth_policy"
+  primary = {
+    cert = {
+      allowed             = true
+      allow_expired_certs = false
+    }
+    updb = {
+      allowed                  = true
+      min_password_length      = 12
+      require_mixed_case       = true
+      require_number_char      = true
+      require_special_char     = true
+      max_attempts             = 5
+      lockout_duration_minutes = 15
+    }
+    ext_jwt = {
+      allowed = false
+    }
+  }
+  secondary = {
+    require_totp = true
+  }
+  tags = {
+    test_value = "test"
+  }
+}
@@ -0,0 +1,29 @@
+resource "ziti_identity" "break_glass" {
+  name     = "break-glass-admin"
+  is_admin = true
+}
+
+variable "break_glass_password" {
+  type      = string
+  sensitive = true
+}
+
+# The password is write-only, bump password_version to re-key the authenticator in place with it.
+resource "ziti_authenticator" "break_glass_updb" {
+  identity_id      = ziti_identity.break_glass.id
+  method           = "updb"
+  username         = "break-glass-admin"
+  password         = var.break_glass_password
+  password_version = 1
+}
+
+resource "ziti_identity" "ci" {
+  name = "ci"
+}
+
+# Changing the certificate replaces the authenticator.
+resource "ziti_authenticator" "ci_cert" {
+  identity_id = ziti_identity.ci.id
+  method      = "cert"
+  cert_pem    = file("${path.module}/ci.crt")
+}
@@ -0,0 +1,34 @@
+resource "tls_private_key" "test_ca" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P256"
+}
+
+resource "tls_self_signed_cert" "test_ca" {
+  private_key_pem       = tls_private_key.test_ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 8760
+  allowed_uses          = ["cert_signing", "crl_signing", "digital_signature"]
+  subject {
+    common_name = "test_ca"
+  }
+}
+
+resource "ziti_certificate_authority" "test_ca" {
+  name                          = "test_ca"
+  cert_pem                      = tls_self_signed_cert.test_ca.cert_pem
+  private_key_pem               = tls_private_key.test_ca.private_key_pem
+  is_auto_ca_enrollment_enabled = true
+  is_ott_ca_enrollment_enabled  = true
+  is_auth_enabled               = true
+  identity_roles                = ["devices"]
+  identity_name_format          = "[caName]-[commonName]"
+  external_id_claim = {
+    location         = "SAN_URI"
+    matcher          = "SCHEME"
+    matcher_criteria = "spiffe"
+    parser           = "NONE"
+  }
+  tags = {
+    test_value = "test"
+  }
+}
@@ -0,0 +1,24 @@
+resource "ziti_config" "test_config" {
+  name        = "test_config"
+  config_type = ziti_config_type.test_config_type.name
+  data = jsonencode({
+    endpoint = "https://app.example.com"
+    timeout  = 30
+  })
+  tags = {
+    test_value = "test"
+  }
+}
+
+resource "ziti_config" "test_intercept" {
+  name        = "test_intercept"
+  config_type = "intercept.v1"
+  data = jsonencode({
+    protocols = ["tcp"]
+    addresses = ["app.ziti"]
+    portRanges = [{
+      low  = 443
+      high = 443
+    }]
+  })
+}
@@ -0,0 +1,21 @@
+resource "ziti_config_type" "test_config_type" {
+  name = "test-app.v1"
+  schema = jsonencode({
+    "$id"                = "http://example.com/schemas/test-app.v1.json"
+    type                 = "object"
+    additionalProperties = false
+    required             = ["endpoint"]
+    properties = {
+      endpoint = {
+        type = "string"
+      }
+      timeout = {
+        type    = "integer"
+        minimum = 0
+      }
+    }
+  })
+  tags = {
+    test_value = "test"
+  }
+}
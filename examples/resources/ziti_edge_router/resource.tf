@@ -0,0 +1,15 @@
+resource "ziti_edge_router" "test_edge_router" {
+  name                = "test_edge_router"
+  role_attributes     = ["public"]
+  cost                = 10
+  is_tunneler_enabled = true
+  tags = {
+    test_value = "test"
+  }
+}
+
+resource "ziti_edge_router_policy" "test_ziti_edge_router_policy" {
+  name              = "test_public_edge_routers"
+  edge_router_roles = ["#public"]
+  identity_roles    = ["#all"]
+}
@@ -0,0 +1,12 @@
+resource "ziti_enrolled_identity" "billing_api" {
+  name            = "billing-api"
+  type            = "Service"
+  role_attributes = ["billing"]
+  key_alg         = "EC"
+}
+
+# Ready to be mounted into the SDK application, eg as a Kubernetes secret.
+resource "local_sensitive_file" "billing_api_identity" {
+  content  = ziti_enrolled_identity.billing_api.identity_json
+  filename = "${path.module}/billing-api.json"
+}
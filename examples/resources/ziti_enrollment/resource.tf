@@ -0,0 +1,27 @@
+resource "ziti_identity" "device" {
+  name = "device"
+}
+
+# Refreshed on apply once it is within a day of its expiration.
+resource "ziti_enrollment" "device_ott" {
+  identity_id    = ziti_identity.device.id
+  method         = "ott"
+  validity       = "168h"
+  refresh_before = "24h"
+}
+
+resource "ziti_identity" "operator" {
+  name = "operator"
+}
+
+resource "ziti_enrollment" "operator_updb" {
+  identity_id = ziti_identity.operator.id
+  method      = "updb"
+  username    = "operator"
+  expires_at  = "2030-01-01T00:00:00Z"
+}
+
+output "device_enrollment_jwt" {
+  value     = ziti_enrollment.device_ott.jwt
+  sensitive = true
+}
@@ -0,0 +1,24 @@
+resource "ziti_ext_jwt_signer" "test_ext_jwt_signer" {
+  name              = "test_ext_jwt_signer"
+  issuer            = "https://idp.example.com/realms/ziti"
+  audience          = "openziti"
+  jwks_endpoint     = "https://idp.example.com/realms/ziti/protocol/openid-connect/certs"
+  claims_property   = "email"
+  use_external_id   = true
+  external_auth_url = "https://idp.example.com/realms/ziti"
+  client_id         = "openziti-client"
+  scopes            = ["openid", "email"]
+  tags = {
+    test_value = "test"
+  }
+}
+
+resource "ziti_auth_policy" "test_auth_policy_ext_jwt" {
+  name = "test_auth_policy_ext_jwt"
+  primary = {
+    ext_jwt = {
+      allowed         = true
+      allowed_signers = [ziti_ext_jwt_signer.test_ext_jwt_signer.id]
+    }
+  }
+}
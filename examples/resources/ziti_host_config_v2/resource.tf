@@ -0,0 +1,41 @@
+resource "ziti_host_config_v2" "active_standby_host" {
+  name = "active_standby.host.v2"
+  terminators = [
+    {
+      address  = "primary.internal"
+      port     = 5432
+      protocol = "tcp"
+      listen_options = {
+        precedence = "required"
+      }
+      port_checks = [
+        {
+          address  = "primary.internal:5432"
+          interval = "5s"
+          timeout  = "2s"
+          actions = [
+            {
+              trigger  = "fail"
+              duration = "10s"
+              action   = "mark unhealthy"
+            },
+            {
+              trigger  = "pass"
+              duration = "10s"
+              action   = "mark healthy"
+            }
+          ]
+        }
+      ]
+    },
+    {
+      address  = "standby.internal"
+      port     = 5432
+      protocol = "tcp"
+      listen_options = {
+        precedence = "default"
+        cost       = 100
+      }
+    }
+  ]
+}
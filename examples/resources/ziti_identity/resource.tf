@@ -24,3 +24,30 @@ resource "ziti_identity" "test_ziti_identity" {
     "${ziti_service.test_service.id}" = 10
   }
 }
+
+resource "ziti_identity" "ott_enrolled_identity" {
+  name = "ott_identity"
+  enrollment = {
+    method = "ott"
+  }
+}
+
+resource "ziti_identity" "updb_enrolled_identity" {
+  name = "updb_identity"
+  enrollment = {
+    method   = "updb"
+    username = "updb_identity"
+  }
+}
+
+# Disabled for an hour during an incident, enabled back automatically afterwards.
+resource "ziti_identity" "suspended_identity" {
+  name                      = "suspended_identity"
+  disabled                  = true
+  disabled_duration_minutes = 60
+}
+
+output "ott_enrollment_jwt" {
+  value     = ziti_identity.ott_enrolled_identity.enrollment_jwt
+  sensitive = true
+}
@@ -0,0 +1,43 @@
+resource "ziti_intercept_config_v1" "default_intercept" {
+  name      = "db.intercept.v1"
+  addresses = ["db.ziti"]
+  protocols = ["tcp"]
+  port_ranges = [
+    {
+      low  = 5432
+      high = 5432
+    }
+  ]
+}
+
+resource "ziti_intercept_config_v1" "site_a_intercept" {
+  name      = "site-a.intercept.v1"
+  addresses = ["db.site-a.internal"]
+  protocols = ["tcp"]
+  port_ranges = [
+    {
+      low  = 5432
+      high = 5432
+    }
+  ]
+}
+
+resource "ziti_service" "db" {
+  name    = "db"
+  configs = [ziti_intercept_config_v1.default_intercept.id]
+}
+
+resource "ziti_identity" "site_a_gateway" {
+  name = "site-a-gateway"
+}
+
+# Any other override of the identity is removed.
+resource "ziti_identity_service_config" "site_a_gateway" {
+  identity_id = ziti_identity.site_a_gateway.id
+  service_configs = [
+    {
+      service_id = ziti_service.db.id
+      config_id  = ziti_intercept_config_v1.site_a_intercept.id
+    }
+  ]
+}
@@ -0,0 +1,6 @@
+resource "ziti_proxy_config_v1" "postgres_proxy" {
+  name      = "postgres.proxy.v1"
+  port      = 15432
+  protocols = ["tcp"]
+  binding   = "127.0.0.1"
+}
@@ -0,0 +1,11 @@
+resource "ziti_terminator" "test_terminator" {
+  service_id = ziti_service.test_service.id
+  router_id  = ziti_edge_router.test_edge_router.id
+  binding    = "transport"
+  address    = "tcp:localhost:8080"
+  cost       = 10
+  precedence = "default"
+  tags = {
+    test_value = "test"
+  }
+}
@@ -0,0 +1,8 @@
+resource "ziti_transit_router" "test_transit_router" {
+  name         = "test_transit_router"
+  cost         = 10
+  no_traversal = false
+  tags = {
+    test_value = "test"
+  }
+}
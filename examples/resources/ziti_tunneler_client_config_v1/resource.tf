@@ -0,0 +1,5 @@
+resource "ziti_tunneler_client_config_v1" "postgres_client" {
+  name     = "postgres.ziti-tunneler-client.v1"
+  hostname = "postgres.ziti"
+  port     = 5432
+}
@@ -0,0 +1,24 @@
+resource "ziti_tunneler_server_config_v1" "postgres_server" {
+  name     = "postgres.ziti-tunneler-server.v1"
+  hostname = "localhost"
+  port     = 5432
+  protocol = "tcp"
+  listen_options = {
+    connect_timeout_seconds = 10
+    precedence              = "required"
+  }
+  port_checks = [
+    {
+      address  = "localhost:5432"
+      interval = "5s"
+      timeout  = "2s"
+      actions = [
+        {
+          trigger  = "fail"
+          duration = "10s"
+          action   = "mark unhealthy"
+        }
+      ]
+    }
+  ]
+}
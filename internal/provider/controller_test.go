@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fullsailor/pkcs7"
+	"github.com/openziti/sdk-golang/edge-apis"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeController is a Ziti controller serving the handlers a test registers on Mux over TLS, with a CA of its own
+// which signs its server certificate, its enrollment JWTs and the certificates of enrolled identities.
+type fakeController struct {
+	*httptest.Server
+	Mux *http.ServeMux
+
+	key  *ecdsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func newFakeController(t *testing.T) *fakeController {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-controller"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	controller := &fakeController{
+		Mux:  http.NewServeMux(),
+		key:  key,
+		cert: cert,
+	}
+	controller.Server = httptest.NewUnstartedServer(controller.Mux)
+	controller.Server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+	controller.StartTLS()
+	t.Cleanup(controller.Close)
+	return controller
+}
+
+// CaPool returns a pool trusting the CA of the controller.
+func (c *fakeController) CaPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(c.cert)
+	return pool
+}
+
+// ManagementUrl returns the URL of the Edge Management API of the controller.
+func (c *fakeController) ManagementUrl(t *testing.T) *url.URL {
+	managementUrl, err := url.Parse(edge_apis.ManagementUrl(c.URL))
+	require.NoError(t, err)
+	return managementUrl
+}
+
+// ManagementApiClient returns a client of the Edge Management API of the controller, which has not authenticated.
+func (c *fakeController) ManagementApiClient(t *testing.T) *edge_apis.ManagementApiClient {
+	return edge_apis.NewManagementApiClient([]*url.URL{c.ManagementUrl(t)}, c.CaPool(), nil)
+}
+
+// EnrollmentJwt returns an ott enrollment JWT of the identity, signed by the controller.
+func (c *fakeController) EnrollmentJwt(t *testing.T, identityId string, token string) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iss": c.URL,
+		"sub": identityId,
+		"jti": token,
+		"em":  "ott",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, c.key, digest[:])
+	require.NoError(t, err)
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// HandleOttEnrollment serves what the SDK needs to complete an ott enrollment with the given token: the server
+// certificate, the CAs of the controller and the signing of the certificate request.
+func (c *fakeController) HandleOttEnrollment(t *testing.T, token string) {
+	c.Mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	c.Mux.HandleFunc("GET /edge/client/v1/.well-known/est/cacerts", func(w http.ResponseWriter, r *http.Request) {
+		certs, err := pkcs7.DegenerateCertificate(c.cert.Raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pkcs7-mime")
+		_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(certs)))
+	})
+	c.Mux.HandleFunc("POST /edge/client/v1/enroll", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("method") != "ott" || r.URL.Query().Get("token") != token {
+			http.Error(w, `{"error":{"code":"INVALID_ENROLLMENT_TOKEN","message":"invalid token"}}`, http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		block, _ := pem.Decode(body)
+		if block == nil {
+			http.Error(w, "no certificate request", http.StatusBadRequest)
+			return
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, c.cert, csr.PublicKey, c.key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	})
+}
+
+// HandleAuthentication serves the password authentication of the Edge Management API. The n-th authentication
+// creates an API session whose token is "token-n". It returns the number of authentications.
+func (c *fakeController) HandleAuthentication() *atomic.Int32 {
+	var sessions atomic.Int32
+	c.Mux.HandleFunc("POST /edge/management/v1/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		session := strconv.Itoa(int(sessions.Add(1)))
+		writeJson(w, http.StatusOK, map[string]any{
+			"data": map[string]any{"id": "session-" + session, "token": "token-" + session},
+			"meta": map[string]any{},
+		})
+	})
+	c.Mux.HandleFunc("GET /edge/management/v1/controllers", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, http.StatusOK, map[string]any{"data": []any{}, "meta": map[string]any{}})
+	})
+	return &sessions
+}
+
+// writeJson writes a JSON response with the given status code.
+func writeJson(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeApiError writes an error response of the Edge Management API.
+func writeApiError(w http.ResponseWriter, code int, errorCode string) {
+	writeJson(w, code, map[string]any{
+		"error": map[string]any{"code": errorCode, "message": http.StatusText(code)},
+		"meta":  map[string]any{},
+	})
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/auth_policy"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiAuthPolicyDataSource{}
+
+func NewZitiAuthPolicyDataSource() datasource.DataSource {
+	return &ZitiAuthPolicyDataSource{}
+}
+
+// ZitiAuthPolicyDataSource defines the data source implementation.
+type ZitiAuthPolicyDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiAuthPolicyDataSourceModel describes the data source data model.
+type ZitiAuthPolicyDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	Primary   types.Object `tfsdk:"primary"`
+	Secondary types.Object `tfsdk:"secondary"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func (d *ZitiAuthPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiAuthPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_policy"
+}
+
+func (d *ZitiAuthPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define an auth policy of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the auth policy",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the auth policy",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the auth policy",
+				Computed:            true,
+			},
+			"primary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Primary authentication methods allowed by the policy",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"cert": schema.SingleNestedAttribute{
+						MarkdownDescription: "Certificate based authentication settings",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Whether certificate authentication is allowed",
+								Computed:            true,
+							},
+							"allow_expired_certs": schema.BoolAttribute{
+								MarkdownDescription: "Whether expired certificates are allowed to authenticate",
+								Computed:            true,
+							},
+						},
+					},
+					"updb": schema.SingleNestedAttribute{
+						MarkdownDescription: "Username/password authentication settings",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Whether username/password authentication is allowed",
+								Computed:            true,
+							},
+							"min_password_length": schema.Int64Attribute{
+								MarkdownDescription: "Minimal length of a password",
+								Computed:            true,
+							},
+							"require_mixed_case": schema.BoolAttribute{
+								MarkdownDescription: "Whether a password must contain both lower and upper case characters",
+								Computed:            true,
+							},
+							"require_number_char": schema.BoolAttribute{
+								MarkdownDescription: "Whether a password must contain a number",
+								Computed:            true,
+							},
+							"require_special_char": schema.BoolAttribute{
+								MarkdownDescription: "Whether a password must contain a special character",
+								Computed:            true,
+							},
+							"max_attempts": schema.Int64Attribute{
+								MarkdownDescription: "Amount of failed attempts before an identity is locked out. 0 indicates no limit",
+								Computed:            true,
+							},
+							"lockout_duration_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Duration of a lockout in minutes. 0 indicates a lockout until an administrator intervenes",
+								Computed:            true,
+							},
+						},
+					},
+					"ext_jwt": schema.SingleNestedAttribute{
+						MarkdownDescription: "External JWT authentication settings",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Whether external JWT authentication is allowed",
+								Computed:            true,
+							},
+							"allowed_signers": schema.ListAttribute{
+								ElementType:         types.StringType,
+								MarkdownDescription: "A list of external JWT signer ids allowed to authenticate",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+			"secondary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Secondary authentication requirements of the policy",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"require_totp": schema.BoolAttribute{
+						MarkdownDescription: "Whether TOTP MFA is required",
+						Computed:            true,
+					},
+					"require_ext_jwt_signer": schema.StringAttribute{
+						MarkdownDescription: "An id of an external JWT signer whose token is required as a secondary factor",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZitiAuthPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiAuthPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiAuthPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := auth_policy.NewListAuthPoliciesParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.AuthPolicy.ListAuthPolicies(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Auth Policies from API",
+			"Could not read Ziti Auth Policies "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	authPolicies := data.Payload.Data
+	if len(authPolicies) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(authPolicies) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	authPolicy := authPolicies[0]
+
+	state.Name = types.StringValue(*authPolicy.Name)
+	state.ID = types.StringValue(*authPolicy.ID)
+
+	primary, diags := AuthPolicyPrimaryToObject(ctx, authPolicy.Primary)
+	resp.Diagnostics.Append(diags...)
+	state.Primary = primary
+
+	secondary, diags := AuthPolicySecondaryToObject(ctx, authPolicy.Secondary)
+	resp.Diagnostics.Append(diags...)
+	state.Secondary = secondary
+
+	if len(authPolicy.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, authPolicy.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
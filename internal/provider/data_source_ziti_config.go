@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiConfigDataSource{}
+
+func NewZitiConfigDataSource() datasource.DataSource {
+	return &ZitiConfigDataSource{}
+}
+
+// ZitiConfigDataSource defines the data source implementation.
+type ZitiConfigDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiConfigDataSourceModel describes the data source data model.
+type ZitiConfigDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	ConfigTypeID   types.String  `tfsdk:"config_type_id"`
+	ConfigTypeName types.String  `tfsdk:"config_type_name"`
+	Data           types.Dynamic `tfsdk:"data"`
+	DataJson       types.String  `tfsdk:"data_json"`
+	Tags           types.Map     `tfsdk:"tags"`
+}
+
+func (d *ZitiConfigDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (d *ZitiConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define a config of any config type of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the config",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"config_type_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config type",
+				Computed:            true,
+			},
+			"config_type_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the config type",
+				Computed:            true,
+			},
+			"data": schema.DynamicAttribute{
+				MarkdownDescription: "A decoded data of the config, which attributes can be referenced directly",
+				Computed:            true,
+			},
+			"data_json": schema.StringAttribute{
+				MarkdownDescription: "A JSON encoded data of the config",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the config",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZitiConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewListConfigsParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.Config.ListConfigs(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Configs from API",
+			"Could not read Ziti Configs "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	configs := data.Payload.Data
+	if len(configs) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(configs) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configDetail := configs[0]
+
+	state.Name = types.StringValue(*configDetail.Name)
+	state.ID = types.StringValue(*configDetail.ID)
+
+	state.ConfigTypeID = types.StringPointerValue(configDetail.ConfigTypeID)
+	if configDetail.ConfigType != nil {
+		state.ConfigTypeName = types.StringValue(configDetail.ConfigType.Name)
+	} else {
+		state.ConfigTypeName = types.StringNull()
+	}
+
+	dataJson, err := json.Marshal(configDetail.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config data",
+			"Could not marshal the data of Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.DataJson = types.StringValue(string(dataJson))
+
+	dataValue, diags := NativeJsonToTerraformValue(ctx, configDetail.Data)
+	resp.Diagnostics.Append(diags...)
+	state.Data = types.DynamicValue(dataValue)
+
+	if len(configDetail.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, configDetail.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiConfigTypeDataSource{}
+
+func NewZitiConfigTypeDataSource() datasource.DataSource {
+	return &ZitiConfigTypeDataSource{}
+}
+
+// ZitiConfigTypeDataSource defines the data source implementation.
+type ZitiConfigTypeDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiConfigTypeDataSourceModel describes the data source data model.
+type ZitiConfigTypeDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	Schema types.String `tfsdk:"schema"`
+	Tags   types.Map    `tfsdk:"tags"`
+}
+
+func (d *ZitiConfigTypeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiConfigTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_type"
+}
+
+func (d *ZitiConfigTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define a config type of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config type",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the config type",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "A JSON schema configs of the type are validated against",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the config type",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZitiConfigTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiConfigTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiConfigTypeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewListConfigTypesParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.Config.ListConfigTypes(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Types from API",
+			"Could not read Ziti Config Types "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	configTypes := data.Payload.Data
+	if len(configTypes) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(configTypes) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configType := configTypes[0]
+
+	state.Name = types.StringValue(*configType.Name)
+	state.ID = types.StringValue(*configType.ID)
+
+	if schema := ConfigTypeSchema(configType); len(schema) != 0 {
+		schemaJson, err := json.Marshal(schema)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling Ziti Config Type schema",
+				"Could not marshal the schema of Ziti Config Type "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		state.Schema = types.StringValue(string(schemaJson))
+	} else {
+		state.Schema = types.StringNull()
+	}
+
+	if len(configType.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, configType.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/edge_router"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiEdgeRouterDataSource{}
+
+func NewZitiEdgeRouterDataSource() datasource.DataSource {
+	return &ZitiEdgeRouterDataSource{}
+}
+
+// ZitiEdgeRouterDataSource defines the data source implementation.
+type ZitiEdgeRouterDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiEdgeRouterDataSourceModel describes the data source data model.
+type ZitiEdgeRouterDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	RoleAttributes    types.List  `tfsdk:"role_attributes"`
+	Cost              types.Int64 `tfsdk:"cost"`
+	NoTraversal       types.Bool  `tfsdk:"no_traversal"`
+	Disabled          types.Bool  `tfsdk:"disabled"`
+	IsTunnelerEnabled types.Bool  `tfsdk:"is_tunneler_enabled"`
+	AppData           types.Map   `tfsdk:"app_data"`
+	Tags              types.Map   `tfsdk:"tags"`
+
+	EnrollmentJWT       types.String `tfsdk:"enrollment_jwt"`
+	EnrollmentCreatedAt types.String `tfsdk:"enrollment_created_at"`
+	EnrollmentExpiresAt types.String `tfsdk:"enrollment_expires_at"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	Hostname            types.String `tfsdk:"hostname"`
+	IsOnline            types.Bool   `tfsdk:"is_online"`
+	IsVerified          types.Bool   `tfsdk:"is_verified"`
+	SyncStatus          types.String `tfsdk:"sync_status"`
+}
+
+func (d *ZitiEdgeRouterDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiEdgeRouterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_router"
+}
+
+func (d *ZitiEdgeRouterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define an edge router of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the edge router",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the edge router",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"role_attributes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of role attributes",
+				Computed:            true,
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "A cost of routing traffic through the edge router",
+				Computed:            true,
+			},
+			"no_traversal": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router is prevented from being used as a transit hop",
+				Computed:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router is disabled",
+				Computed:            true,
+			},
+			"is_tunneler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router is able to act as a tunneler",
+				Computed:            true,
+			},
+			"app_data": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "AppData of the edge router",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the edge router",
+				Computed:            true,
+			},
+			"enrollment_jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the edge router with. Empty once the edge router is enrolled",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"enrollment_created_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment was created",
+				Computed:            true,
+			},
+			"enrollment_expires_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment expires",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the edge router certificate",
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "A hostname the edge router reported to the controller",
+				Computed:            true,
+			},
+			"is_online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router is currently connected to the controller",
+				Computed:            true,
+			},
+			"is_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router has completed enrollment",
+				Computed:            true,
+			},
+			"sync_status": schema.StringAttribute{
+				MarkdownDescription: "A sync status of the edge router",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZitiEdgeRouterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiEdgeRouterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiEdgeRouterDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := edge_router.NewListEdgeRoutersParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.EdgeRouter.ListEdgeRouters(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Edge Routers from API",
+			"Could not read Ziti Edge Routers "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	edgeRouters := data.Payload.Data
+	if len(edgeRouters) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(edgeRouters) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	edgeRouter := edgeRouters[0]
+
+	state.Name = types.StringValue(*edgeRouter.Name)
+	state.ID = types.StringValue(*edgeRouter.ID)
+
+	if edgeRouter.RoleAttributes != nil && len(*edgeRouter.RoleAttributes) > 0 {
+		roleAttributes, diag := types.ListValueFrom(ctx, types.StringType, *edgeRouter.RoleAttributes)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.RoleAttributes = roleAttributes
+	} else {
+		state.RoleAttributes = types.ListNull(types.StringType)
+	}
+
+	state.Cost = types.Int64PointerValue(edgeRouter.Cost)
+	state.NoTraversal = types.BoolPointerValue(edgeRouter.NoTraversal)
+	state.Disabled = types.BoolPointerValue(edgeRouter.Disabled)
+	state.IsTunnelerEnabled = types.BoolPointerValue(edgeRouter.IsTunnelerEnabled)
+
+	if edgeRouter.AppData != nil && len(edgeRouter.AppData.SubTags) != 0 {
+		appData, diag := types.MapValueFrom(ctx, types.StringType, edgeRouter.AppData.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.AppData = appData
+	} else {
+		state.AppData = types.MapNull(types.StringType)
+	}
+
+	if len(edgeRouter.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, edgeRouter.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	// Reuse the resource model conversion for the computed enrollment and status values.
+	var computed ZitiEdgeRouterResourceModel
+	computed.SetComputedFromDetail(edgeRouter)
+	state.EnrollmentJWT = computed.EnrollmentJWT
+	state.EnrollmentCreatedAt = computed.EnrollmentCreatedAt
+	state.EnrollmentExpiresAt = computed.EnrollmentExpiresAt
+	state.Fingerprint = computed.Fingerprint
+	state.Hostname = computed.Hostname
+	state.IsOnline = computed.IsOnline
+	state.IsVerified = computed.IsVerified
+	state.SyncStatus = computed.SyncStatus
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
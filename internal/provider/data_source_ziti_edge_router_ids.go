@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/edge_router"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiEdgeRouterIdsDataSource{}
+
+func NewZitiEdgeRouterIdsDataSource() datasource.DataSource {
+	return &ZitiEdgeRouterIdsDataSource{}
+}
+
+// ZitiEdgeRouterIdsDataSource defines the resource implementation.
+type ZitiEdgeRouterIdsDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiEdgeRouterIdsDataSourceModel describes the resource data model.
+
+type ZitiEdgeRouterIdsDataSourceModel struct {
+	IDS    types.List   `tfsdk:"ids"`
+	Filter types.String `tfsdk:"filter"`
+}
+
+func (d *ZitiEdgeRouterIdsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_router_ids"
+}
+
+func (d *ZitiEdgeRouterIdsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CommonIdsDataSourceSchema
+}
+
+func (d *ZitiEdgeRouterIdsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiEdgeRouterIdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiEdgeRouterIdsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := edge_router.NewListEdgeRoutersParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+
+	filter := state.Filter.ValueString()
+	params.Filter = &filter
+	data, err := d.client.API.EdgeRouter.ListEdgeRouters(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Edge Routers from API",
+			"Could not read Ziti Edge Routers IDs "+state.Filter.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	edgeRouters := data.Payload.Data
+	if len(edgeRouters) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	for _, edgeRouter := range edgeRouters {
+		ids = append(ids, *edgeRouter.ID)
+	}
+
+	idsList, _ := types.ListValueFrom(ctx, types.StringType, ids)
+	state.IDS = idsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+}
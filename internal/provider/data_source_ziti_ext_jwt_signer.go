@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/external_jwt_signer"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiExtJwtSignerDataSource{}
+
+func NewZitiExtJwtSignerDataSource() datasource.DataSource {
+	return &ZitiExtJwtSignerDataSource{}
+}
+
+// ZitiExtJwtSignerDataSource defines the data source implementation.
+type ZitiExtJwtSignerDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiExtJwtSignerDataSourceModel describes the data source data model.
+type ZitiExtJwtSignerDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	Issuer          types.String `tfsdk:"issuer"`
+	Audience        types.String `tfsdk:"audience"`
+	JwksEndpoint    types.String `tfsdk:"jwks_endpoint"`
+	CertPem         types.String `tfsdk:"cert_pem"`
+	Kid             types.String `tfsdk:"kid"`
+	ClaimsProperty  types.String `tfsdk:"claims_property"`
+	UseExternalID   types.Bool   `tfsdk:"use_external_id"`
+	ExternalAuthURL types.String `tfsdk:"external_auth_url"`
+	ClientID        types.String `tfsdk:"client_id"`
+	Scopes          types.List   `tfsdk:"scopes"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Tags            types.Map    `tfsdk:"tags"`
+
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	CommonName  types.String `tfsdk:"common_name"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+}
+
+func (d *ZitiExtJwtSignerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiExtJwtSignerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ext_jwt_signer"
+}
+
+func (d *ZitiExtJwtSignerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define an external JWT signer of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the external JWT signer",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the external JWT signer",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "An expected value of the `iss` claim of the JWTs",
+				Computed:            true,
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "An expected value of the `aud` claim of the JWTs",
+				Computed:            true,
+			},
+			"jwks_endpoint": schema.StringAttribute{
+				MarkdownDescription: "A URL of the JWKS endpoint to fetch signing keys from",
+				Computed:            true,
+			},
+			"cert_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded certificate the JWTs are signed with",
+				Computed:            true,
+			},
+			"kid": schema.StringAttribute{
+				MarkdownDescription: "A key id of the certificate set in `cert_pem`",
+				Computed:            true,
+			},
+			"claims_property": schema.StringAttribute{
+				MarkdownDescription: "A JWT claim to match against identities",
+				Computed:            true,
+			},
+			"use_external_id": schema.BoolAttribute{
+				MarkdownDescription: "Whether `claims_property` is matched against the `external_id` of identities instead of their ids",
+				Computed:            true,
+			},
+			"external_auth_url": schema.StringAttribute{
+				MarkdownDescription: "A URL clients are directed to in order to obtain a JWT",
+				Computed:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "An OIDC client id clients use to obtain a JWT",
+				Computed:            true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of OIDC scopes clients request when obtaining a JWT",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the external JWT signer is enabled",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the external JWT signer",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the certificate set in `cert_pem`",
+				Computed:            true,
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "A common name of the certificate set in `cert_pem`",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "A timestamp the certificate set in `cert_pem` is valid from",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "A timestamp the certificate set in `cert_pem` is valid until",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZitiExtJwtSignerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiExtJwtSignerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiExtJwtSignerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := external_jwt_signer.NewListExternalJWTSignersParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.ExternalJWTSigner.ListExternalJWTSigners(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti External JWT Signers from API",
+			"Could not read Ziti External JWT Signers "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	signers := data.Payload.Data
+	if len(signers) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(signers) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	signer := signers[0]
+
+	state.ID = types.StringValue(*signer.ID)
+
+	// Reuse the resource model conversion for the signer attributes.
+	var detail ZitiExtJwtSignerResourceModel
+	resp.Diagnostics.Append(detail.SetFromDetail(ctx, signer)...)
+	state.Name = detail.Name
+	state.Issuer = detail.Issuer
+	state.Audience = detail.Audience
+	state.JwksEndpoint = detail.JwksEndpoint
+	state.CertPem = detail.CertPem
+	state.Kid = detail.Kid
+	state.ClaimsProperty = detail.ClaimsProperty
+	state.UseExternalID = detail.UseExternalID
+	state.ExternalAuthURL = detail.ExternalAuthURL
+	state.ClientID = detail.ClientID
+	state.Scopes = detail.Scopes
+	state.Enabled = detail.Enabled
+	state.Tags = detail.Tags
+	state.Fingerprint = detail.Fingerprint
+	state.CommonName = detail.CommonName
+	state.NotBefore = detail.NotBefore
+	state.NotAfter = detail.NotAfter
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -71,192 +71,197 @@ func (d *ZitiHostConfigDataSource) Metadata(ctx context.Context, req datasource.
 }
 
 func (d *ZitiHostConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := hostConfigDataSourceAttributes()
+	attributes["filter"] = schema.StringAttribute{
+		MarkdownDescription: "ZitiQl filter query",
+		Optional:            true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Example identifier",
+		Computed:            true,
+		Optional:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:            true,
+		Optional:            true,
+		MarkdownDescription: "Name of a config",
+	}
+	attributes["most_recent"] = schema.BoolAttribute{
+		MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+		Optional:            true,
+	}
+	attributes["config_type_id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "configTypeId",
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Ziti Host Config Data Source",
 
-		Attributes: map[string]schema.Attribute{
-			"filter": schema.StringAttribute{
-				MarkdownDescription: "ZitiQl filter query",
-				Optional:            true,
-			},
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Example identifier",
-				Computed:            true,
-				Optional:            true,
-			},
-			"name": schema.StringAttribute{
-				Computed:            true,
-				Optional:            true,
-				MarkdownDescription: "Name of a config",
-			},
-			"most_recent": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
-				Optional:            true,
-			},
+		Attributes: attributes,
+	}
+}
 
-			"address": schema.StringAttribute{
-				MarkdownDescription: "A target host config address towards which traffic would be relayed.",
-				Computed:            true,
-			},
-			"port": schema.Int32Attribute{
-				MarkdownDescription: "A port of a target address towards which traffic would be relayed",
-				Computed:            true,
-			},
-			"protocol": schema.StringAttribute{
-				MarkdownDescription: "A protocol which config would be allowed to receive",
-				Computed:            true,
-			},
-			"forward_protocol": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedProtocols",
-				Computed:            true,
-			},
-			"forward_port": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedPortRanges",
-				Computed:            true,
-			},
-			"forward_address": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedAddresses",
-				Computed:            true,
-			},
-			"allowed_addresses": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed addresses that could be forwarded.",
-				Computed:            true,
-			},
-			"allowed_source_addresses": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed source addresses that could be forwarded.",
-				Computed:            true,
+// hostConfigDataSourceAttributes returns the computed attributes of a host.v1 config, shared with the terminators of host.v2.
+func hostConfigDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			MarkdownDescription: "A target host config address towards which traffic would be relayed.",
+			Computed:            true,
+		},
+		"port": schema.Int32Attribute{
+			MarkdownDescription: "A port of a target address towards which traffic would be relayed",
+			Computed:            true,
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "A protocol which config would be allowed to receive",
+			Computed:            true,
+		},
+		"forward_protocol": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedProtocols",
+			Computed:            true,
+		},
+		"forward_port": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedPortRanges",
+			Computed:            true,
+		},
+		"forward_address": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedAddresses",
+			Computed:            true,
+		},
+		"allowed_addresses": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed addresses that could be forwarded.",
+			Computed:            true,
+		},
+		"allowed_source_addresses": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed source addresses that could be forwarded.",
+			Computed:            true,
+		},
+		"listen_options": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"bind_using_edge_identity": schema.BoolAttribute{
+					Computed: true,
+				},
+				"connect_timeout": schema.StringAttribute{
+					Computed: true,
+				},
+				"cost": schema.Int32Attribute{
+					Computed: true,
+				},
+				"max_connections": schema.Int32Attribute{
+					Computed: true,
+				},
+				"precedence": schema.StringAttribute{
+					Computed: true,
+				},
 			},
-			"listen_options": schema.SingleNestedAttribute{
-				Computed: true,
+		},
+		"http_checks": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"bind_using_edge_identity": schema.BoolAttribute{
+					"url": schema.StringAttribute{
 						Computed: true,
 					},
-					"connect_timeout": schema.StringAttribute{
+					"method": schema.StringAttribute{
 						Computed: true,
 					},
-					"cost": schema.Int32Attribute{
+					"body": schema.StringAttribute{
 						Computed: true,
 					},
-					"max_connections": schema.Int32Attribute{
+					"expect_status": schema.Int32Attribute{
 						Computed: true,
 					},
-					"precedence": schema.StringAttribute{
+					"expect_in_body": schema.StringAttribute{
 						Computed: true,
 					},
-				},
-			},
-			"http_checks": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"url": schema.StringAttribute{
-							Computed: true,
-						},
-						"method": schema.StringAttribute{
-							Computed: true,
-						},
-						"body": schema.StringAttribute{
-							Computed: true,
-						},
-						"expect_status": schema.Int32Attribute{
-							Computed: true,
-						},
-						"expect_in_body": schema.StringAttribute{
-							Computed: true,
-						},
-						"interval": schema.StringAttribute{
-							Computed: true,
-						},
-						"timeout": schema.StringAttribute{
-							Computed: true,
-						},
-						"actions": schema.ListNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"trigger": schema.StringAttribute{
-										Computed: true,
-									},
-									"duration": schema.StringAttribute{
-										Computed: true,
-									},
-									"action": schema.StringAttribute{
-										Computed: true,
-									},
-									"consecutive_events": schema.Int32Attribute{
-										Computed: true,
-									},
+					"interval": schema.StringAttribute{
+						Computed: true,
+					},
+					"timeout": schema.StringAttribute{
+						Computed: true,
+					},
+					"actions": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"trigger": schema.StringAttribute{
+									Computed: true,
+								},
+								"duration": schema.StringAttribute{
+									Computed: true,
+								},
+								"action": schema.StringAttribute{
+									Computed: true,
+								},
+								"consecutive_events": schema.Int32Attribute{
+									Computed: true,
 								},
 							},
-							MarkdownDescription: "An array of actions to take upon health check result.",
-							Computed:            true,
 						},
+						MarkdownDescription: "An array of actions to take upon health check result.",
+						Computed:            true,
 					},
 				},
 			},
-			"port_checks": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"address": schema.StringAttribute{
-							Computed: true,
-						},
-						"interval": schema.StringAttribute{
-							Computed: true,
-						},
-						"timeout": schema.StringAttribute{
-							Computed: true,
-						},
-						"actions": schema.ListNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"trigger": schema.StringAttribute{
-										Computed: true,
-									},
-									"duration": schema.StringAttribute{
-										Computed: true,
-									},
-									"action": schema.StringAttribute{
-										Computed: true,
-									},
-									"consecutive_events": schema.Int32Attribute{
-										Computed: true,
-									},
+		},
+		"port_checks": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Computed: true,
+					},
+					"interval": schema.StringAttribute{
+						Computed: true,
+					},
+					"timeout": schema.StringAttribute{
+						Computed: true,
+					},
+					"actions": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"trigger": schema.StringAttribute{
+									Computed: true,
+								},
+								"duration": schema.StringAttribute{
+									Computed: true,
+								},
+								"action": schema.StringAttribute{
+									Computed: true,
+								},
+								"consecutive_events": schema.Int32Attribute{
+									Computed: true,
 								},
 							},
-							MarkdownDescription: "An array of actions to take upon health check result.",
-							Computed:            true,
 						},
+						MarkdownDescription: "An array of actions to take upon health check result.",
+						Computed:            true,
 					},
 				},
 			},
-			"allowed_protocols": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed protocols that could be forwarded.",
-				Computed:            true,
-			},
-			"allowed_port_ranges": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"low": schema.Int32Attribute{
-							Computed: true,
-						},
-						"high": schema.Int32Attribute{
-							Computed: true,
-						},
+		},
+		"allowed_protocols": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed protocols that could be forwarded.",
+			Computed:            true,
+		},
+		"allowed_port_ranges": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"low": schema.Int32Attribute{
+						Computed: true,
+					},
+					"high": schema.Int32Attribute{
+						Computed: true,
 					},
 				},
-				MarkdownDescription: "An array of allowed ports that could be forwarded.",
-			},
-
-			"config_type_id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "configTypeId",
 			},
+			MarkdownDescription: "An array of allowed ports that could be forwarded.",
 		},
 	}
 }
@@ -325,7 +330,15 @@ func (d *ZitiHostConfigDataSource) Read(ctx context.Context, req datasource.Read
 		filter = state.Filter.ValueString()
 	}
 
-	filter = filter + " and type = \"NH5p4FpGR\"" //host.v1 config
+	configTypeId, err := GetConfigTypeIdByName(d.client, "host.v1")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not find the host.v1 config type: "+err.Error(),
+		)
+		return
+	}
+	filter = filter + " and type = \"" + configTypeId + "\""
 	params.Filter = &filter
 	data, err := d.client.API.Config.ListConfigs(params, nil)
 	if err != nil {
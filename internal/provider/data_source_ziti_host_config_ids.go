@@ -78,7 +78,15 @@ func (d *ZitiHostConfigIdsDataSource) Read(ctx context.Context, req datasource.R
 	params.Offset = &offset
 
 	filter := state.Filter.ValueString()
-	filter = filter + " and type = \"NH5p4FpGR\"" //host.v1 config
+	configTypeId, err := GetConfigTypeIdByName(d.client, "host.v1")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not find the host.v1 config type: "+err.Error(),
+		)
+		return
+	}
+	filter = filter + " and type = \"" + configTypeId + "\""
 	params.Filter = &filter
 
 	data, err := d.client.API.Config.ListConfigs(params, nil)
@@ -63,6 +63,9 @@ type ZitiIdentityDataSourceModel struct {
 	ServiceHostingPrecedence types.Map    `tfsdk:"service_hosting_precedence"`
 	Tags                     types.Map    `tfsdk:"tags"`
 	Type                     types.String `tfsdk:"type"`
+	Disabled                 types.Bool   `tfsdk:"disabled"`
+	DisabledAt               types.String `tfsdk:"disabled_at"`
+	DisabledUntil            types.String `tfsdk:"disabled_until"`
 }
 
 func (d *ZitiIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -142,6 +145,18 @@ func (d *ZitiIdentityDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Type of the identity.",
 				Computed:            true,
 			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the identity is disabled",
+				Computed:            true,
+			},
+			"disabled_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the identity was disabled",
+				Computed:            true,
+			},
+			"disabled_until": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the identity is enabled again. Empty when the identity is disabled indefinitely",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -276,6 +291,17 @@ func (d *ZitiIdentityDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	state.Type = types.StringValue(identityDetail.Type.Name)
+	state.Disabled = types.BoolValue(identityDetail.Disabled != nil && *identityDetail.Disabled)
+	if identityDetail.DisabledAt != nil {
+		state.DisabledAt = types.StringValue(identityDetail.DisabledAt.String())
+	} else {
+		state.DisabledAt = types.StringNull()
+	}
+	if identityDetail.DisabledUntil != nil {
+		state.DisabledUntil = types.StringValue(identityDetail.DisabledUntil.String())
+	} else {
+		state.DisabledUntil = types.StringNull()
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/authenticator"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiIdentityAuthenticatorsDataSource{}
+
+func NewZitiIdentityAuthenticatorsDataSource() datasource.DataSource {
+	return &ZitiIdentityAuthenticatorsDataSource{}
+}
+
+// ZitiIdentityAuthenticatorsDataSource defines the data source implementation.
+type ZitiIdentityAuthenticatorsDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+var AuthenticatorModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"method":      types.StringType,
+		"username":    types.StringType,
+		"cert_pem":    types.StringType,
+		"fingerprint": types.StringType,
+	},
+}
+
+type AuthenticatorObject struct {
+	ID          types.String `tfsdk:"id"`
+	Method      types.String `tfsdk:"method"`
+	Username    types.String `tfsdk:"username"`
+	CertPem     types.String `tfsdk:"cert_pem"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+// ZitiIdentityAuthenticatorsDataSourceModel describes the data source data model.
+type ZitiIdentityAuthenticatorsDataSourceModel struct {
+	IdentityID     types.String `tfsdk:"identity_id"`
+	Filter         types.String `tfsdk:"filter"`
+	Authenticators types.List   `tfsdk:"authenticators"`
+}
+
+func (d *ZitiIdentityAuthenticatorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_authenticators"
+}
+
+func (d *ZitiIdentityAuthenticatorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to list authenticators of a Ziti identity",
+
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to list authenticators of",
+				Required:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"authenticators": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of authenticators of the identity",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the authenticator",
+							Computed:            true,
+						},
+						"method": schema.StringAttribute{
+							MarkdownDescription: "A method of the authenticator: updb or cert",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "A username of the updb authenticator",
+							Computed:            true,
+						},
+						"cert_pem": schema.StringAttribute{
+							MarkdownDescription: "A PEM encoded certificate of the cert authenticator",
+							Computed:            true,
+						},
+						"fingerprint": schema.StringAttribute{
+							MarkdownDescription: "A fingerprint of the certificate of the cert authenticator",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZitiIdentityAuthenticatorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiIdentityAuthenticatorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiIdentityAuthenticatorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := authenticator.NewListAuthenticatorsParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := "identity = \"" + state.IdentityID.ValueString() + "\""
+	if state.Filter.ValueString() != "" {
+		filter = filter + " and (" + state.Filter.ValueString() + ")"
+	}
+	params.Filter = &filter
+
+	data, err := d.client.API.Authenticator.ListAuthenticators(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Authenticators from API",
+			"Could not read Ziti Authenticators of Identity "+state.IdentityID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	authenticators := []AuthenticatorObject{}
+	for _, authenticatorDetail := range data.Payload.Data {
+		authenticators = append(authenticators, AuthenticatorObject{
+			ID:          types.StringPointerValue(authenticatorDetail.ID),
+			Method:      types.StringPointerValue(authenticatorDetail.Method),
+			Username:    stringOrNull(&authenticatorDetail.Username),
+			CertPem:     stringOrNull(&authenticatorDetail.CertPem),
+			Fingerprint: stringOrNull(&authenticatorDetail.Fingerprint),
+		})
+	}
+
+	authenticatorsList, diags := types.ListValueFrom(ctx, AuthenticatorModel, authenticators)
+	resp.Diagnostics.Append(diags...)
+	state.Authenticators = authenticatorsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
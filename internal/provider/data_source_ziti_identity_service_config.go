@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiIdentityServiceConfigDataSource{}
+
+func NewZitiIdentityServiceConfigDataSource() datasource.DataSource {
+	return &ZitiIdentityServiceConfigDataSource{}
+}
+
+// ZitiIdentityServiceConfigDataSource defines the data source implementation.
+type ZitiIdentityServiceConfigDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiIdentityServiceConfigDataSourceModel describes the data source data model.
+type ZitiIdentityServiceConfigDataSourceModel struct {
+	IdentityID     types.String `tfsdk:"identity_id"`
+	ServiceID      types.String `tfsdk:"service_id"`
+	ServiceConfigs types.List   `tfsdk:"service_configs"`
+}
+
+func (d *ZitiIdentityServiceConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_service_config"
+}
+
+func (d *ZitiIdentityServiceConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to list configs a Ziti identity gets for services instead of the configs of the services",
+
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to list the overridden configs of",
+				Required:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the service to narrow the list down to",
+				Optional:            true,
+			},
+			"service_configs": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of service and config pairs of the identity",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the service",
+							Computed:            true,
+						},
+						"config_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the config",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZitiIdentityServiceConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiIdentityServiceConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiIdentityServiceConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceConfigs, err := ListIdentityServiceConfigs(d.client, state.IdentityID.ValueString())
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity Service Configs from API",
+			"Could not read service configs of Ziti Identity "+state.IdentityID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	filtered := []ServiceConfigObject{}
+	for _, serviceConfig := range serviceConfigs {
+		if state.ServiceID.IsNull() || serviceConfig.ServiceID.Equal(state.ServiceID) {
+			filtered = append(filtered, serviceConfig)
+		}
+	}
+
+	serviceConfigsList, diags := types.ListValueFrom(ctx, ServiceConfigModel, filtered)
+	resp.Diagnostics.Append(diags...)
+	state.ServiceConfigs = serviceConfigsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -191,7 +191,15 @@ func (d *ZitiInterceptConfigDataSource) Read(ctx context.Context, req datasource
 		filter = state.Filter.ValueString()
 	}
 
-	filter = filter + " and type = \"g7cIWbcGg\"" //intercept.v1 config
+	configTypeId, err := GetConfigTypeIdByName(d.client, "intercept.v1")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not find the intercept.v1 config type: "+err.Error(),
+		)
+		return
+	}
+	filter = filter + " and type = \"" + configTypeId + "\""
 	params.Filter = &filter
 	data, err := d.client.API.Config.ListConfigs(params, nil)
 	if err != nil {
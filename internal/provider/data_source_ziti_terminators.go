@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/service"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiTerminatorsDataSource{}
+
+func NewZitiTerminatorsDataSource() datasource.DataSource {
+	return &ZitiTerminatorsDataSource{}
+}
+
+// ZitiTerminatorsDataSource defines the data source implementation.
+type ZitiTerminatorsDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+var TerminatorModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.StringType,
+		"router_id":    types.StringType,
+		"binding":      types.StringType,
+		"address":      types.StringType,
+		"identity":     types.StringType,
+		"cost":         types.Int64Type,
+		"dynamic_cost": types.Int64Type,
+		"precedence":   types.StringType,
+	},
+}
+
+type TerminatorObject struct {
+	ID          types.String `tfsdk:"id"`
+	RouterID    types.String `tfsdk:"router_id"`
+	Binding     types.String `tfsdk:"binding"`
+	Address     types.String `tfsdk:"address"`
+	Identity    types.String `tfsdk:"identity"`
+	Cost        types.Int64  `tfsdk:"cost"`
+	DynamicCost types.Int64  `tfsdk:"dynamic_cost"`
+	Precedence  types.String `tfsdk:"precedence"`
+}
+
+// ZitiTerminatorsDataSourceModel describes the data source data model.
+type ZitiTerminatorsDataSourceModel struct {
+	ServiceID   types.String `tfsdk:"service_id"`
+	Filter      types.String `tfsdk:"filter"`
+	Terminators types.List   `tfsdk:"terminators"`
+}
+
+func (d *ZitiTerminatorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminators"
+}
+
+func (d *ZitiTerminatorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to list terminators of a Ziti service",
+
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the service to list terminators of",
+				Required:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"terminators": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of terminators of the service",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the terminator",
+							Computed:            true,
+						},
+						"router_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the router which hosts the terminator",
+							Computed:            true,
+						},
+						"binding": schema.StringAttribute{
+							MarkdownDescription: "A binding of the terminator",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "An address the router dials to reach the hosting server",
+							Computed:            true,
+						},
+						"identity": schema.StringAttribute{
+							MarkdownDescription: "An identity of the terminator",
+							Computed:            true,
+						},
+						"cost": schema.Int64Attribute{
+							MarkdownDescription: "A static cost of the terminator",
+							Computed:            true,
+						},
+						"dynamic_cost": schema.Int64Attribute{
+							MarkdownDescription: "A dynamic cost of the terminator, which is reported by the hosting side",
+							Computed:            true,
+						},
+						"precedence": schema.StringAttribute{
+							MarkdownDescription: "A precedence of the terminator",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZitiTerminatorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiTerminatorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiTerminatorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := service.NewListServiceTerminatorsParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.ID = state.ServiceID.ValueString()
+	params.Limit = &limit
+	params.Offset = &offset
+	if state.Filter.ValueString() != "" {
+		filter := state.Filter.ValueString()
+		params.Filter = &filter
+	}
+
+	data, err := d.client.API.Service.ListServiceTerminators(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Terminators from API",
+			"Could not read Ziti Terminators of Service "+state.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	terminators := []TerminatorObject{}
+	for _, terminatorDetail := range data.Payload.Data {
+		terminatorObject := TerminatorObject{
+			ID:          types.StringPointerValue(terminatorDetail.ID),
+			RouterID:    types.StringPointerValue(terminatorDetail.RouterID),
+			Binding:     types.StringPointerValue(terminatorDetail.Binding),
+			Address:     types.StringPointerValue(terminatorDetail.Address),
+			Identity:    stringOrNull(terminatorDetail.Identity),
+			Cost:        types.Int64Null(),
+			DynamicCost: types.Int64Null(),
+			Precedence:  types.StringNull(),
+		}
+		if terminatorDetail.Cost != nil {
+			terminatorObject.Cost = types.Int64Value(int64(*terminatorDetail.Cost))
+		}
+		if terminatorDetail.DynamicCost != nil {
+			terminatorObject.DynamicCost = types.Int64Value(int64(*terminatorDetail.DynamicCost))
+		}
+		if terminatorDetail.Precedence != nil {
+			terminatorObject.Precedence = types.StringValue(string(*terminatorDetail.Precedence))
+		}
+		terminators = append(terminators, terminatorObject)
+	}
+
+	terminatorsList, diags := types.ListValueFrom(ctx, TerminatorModel, terminators)
+	resp.Diagnostics.Append(diags...)
+	state.Terminators = terminatorsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
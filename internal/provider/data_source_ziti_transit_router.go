@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/router"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiTransitRouterDataSource{}
+
+func NewZitiTransitRouterDataSource() datasource.DataSource {
+	return &ZitiTransitRouterDataSource{}
+}
+
+// ZitiTransitRouterDataSource defines the data source implementation.
+type ZitiTransitRouterDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTransitRouterDataSourceModel describes the data source data model.
+type ZitiTransitRouterDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Name       types.String `tfsdk:"name"`
+
+	Cost        types.Int64 `tfsdk:"cost"`
+	NoTraversal types.Bool  `tfsdk:"no_traversal"`
+	Disabled    types.Bool  `tfsdk:"disabled"`
+	Tags        types.Map   `tfsdk:"tags"`
+
+	EnrollmentJWT       types.String `tfsdk:"enrollment_jwt"`
+	EnrollmentCreatedAt types.String `tfsdk:"enrollment_created_at"`
+	EnrollmentExpiresAt types.String `tfsdk:"enrollment_expires_at"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	IsOnline            types.Bool   `tfsdk:"is_online"`
+	IsVerified          types.Bool   `tfsdk:"is_verified"`
+}
+
+func (d *ZitiTransitRouterDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiTransitRouterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_router"
+}
+
+func (d *ZitiTransitRouterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A datasource to define a transit router of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the transit router",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the transit router",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "A cost of routing traffic through the transit router",
+				Computed:            true,
+			},
+			"no_traversal": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router is prevented from being used as a transit hop",
+				Computed:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router is disabled",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the transit router",
+				Computed:            true,
+			},
+			"enrollment_jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the transit router with. Empty once the transit router is enrolled",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"enrollment_created_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment was created",
+				Computed:            true,
+			},
+			"enrollment_expires_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment expires",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the transit router certificate",
+				Computed:            true,
+			},
+			"is_online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router is currently connected to the controller",
+				Computed:            true,
+			},
+			"is_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router has completed enrollment",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZitiTransitRouterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZitiTransitRouterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiTransitRouterDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := router.NewListTransitRoutersParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	params.Filter = &filter
+	data, err := d.client.API.Router.ListTransitRouters(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Transit Routers from API",
+			"Could not read Ziti Transit Routers "+filter+": "+err.Error(),
+		)
+		return
+	}
+
+	transitRouters := data.Payload.Data
+	if len(transitRouters) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(transitRouters) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	transitRouter := transitRouters[0]
+
+	state.Name = types.StringValue(*transitRouter.Name)
+	state.ID = types.StringValue(*transitRouter.ID)
+
+	state.Cost = types.Int64PointerValue(transitRouter.Cost)
+	state.NoTraversal = types.BoolPointerValue(transitRouter.NoTraversal)
+	state.Disabled = types.BoolPointerValue(transitRouter.Disabled)
+
+	if len(transitRouter.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, transitRouter.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	// Reuse the resource model conversion for the computed enrollment and status values.
+	var computed ZitiTransitRouterResourceModel
+	computed.SetComputedFromDetail(transitRouter)
+	state.EnrollmentJWT = computed.EnrollmentJWT
+	state.EnrollmentCreatedAt = computed.EnrollmentCreatedAt
+	state.EnrollmentExpiresAt = computed.EnrollmentExpiresAt
+	state.Fingerprint = computed.Fingerprint
+	state.IsOnline = computed.IsOnline
+	state.IsVerified = computed.IsVerified
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
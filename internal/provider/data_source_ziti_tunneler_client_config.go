@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZitiTunnelerClientConfigDataSource{}
+
+func NewZitiTunnelerClientConfigDataSource() datasource.DataSource {
+	return &ZitiTunnelerClientConfigDataSource{}
+}
+
+// ZitiTunnelerClientConfigDataSource defines the data source implementation.
+type ZitiTunnelerClientConfigDataSource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTunnelerClientConfigDataSourceModel describes the data source data model.
+type ZitiTunnelerClientConfigDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Filter     types.String `tfsdk:"filter"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+
+	Name         types.String `tfsdk:"name"`
+	ConfigTypeID types.String `tfsdk:"config_type_id"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Port         types.Int32  `tfsdk:"port"`
+}
+
+func (r *ZitiTunnelerClientConfigDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("filter"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZitiTunnelerClientConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tunneler_client_config_v1"
+}
+
+func (d *ZitiTunnelerClientConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Ziti Tunneler Client Config Data Source",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "ZitiQl filter query",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of a config",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of a config",
+			},
+			"most_recent": schema.BoolAttribute{
+				MarkdownDescription: "A flag which controls whether to get the first result from the filter query",
+				Optional:            true,
+			},
+
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "A hostname or an IP address which a tunneler would intercept.",
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "A port which a tunneler would intercept",
+				Computed:            true,
+			},
+			"config_type_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "configTypeId",
+			},
+		},
+	}
+}
+
+func (r *ZitiTunnelerClientConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d *ZitiTunnelerClientConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ZitiTunnelerClientConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewListConfigsParams()
+	var limit int64 = 1000
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := ""
+	if state.ID.ValueString() != "" {
+		filter = "id = \"" + state.ID.ValueString() + "\""
+	} else if state.Name.ValueString() != "" {
+		filter = "name = \"" + state.Name.ValueString() + "\""
+	} else {
+		filter = state.Filter.ValueString()
+	}
+
+	configTypeId, err := GetConfigTypeIdByName(d.client, "ziti-tunneler-client.v1")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not find the ziti-tunneler-client.v1 config type: "+err.Error(),
+		)
+		return
+	}
+	filter = filter + " and type = \"" + configTypeId + "\""
+	params.Filter = &filter
+	data, err := d.client.API.Config.ListConfigs(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	configLists := data.Payload.Data
+	if len(configLists) > 1 && !state.MostRecent.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Multiple items returned from API upon filter execution!",
+			"Try to narrow down the filter expression, or set most_recent to true to get the first result: "+filter,
+		)
+	}
+	if len(configLists) == 0 {
+		resp.Diagnostics.AddError(
+			"No items returned from API upon filter execution!",
+			"Try to relax the filter expression: "+filter,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	configList := configLists[0]
+	responseData, ok := configList.Data.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error casting a response from a ziti controller to a dictionary",
+			"Could not cast a response from ziti to a dictionary",
+		)
+		return
+	}
+
+	var tunnelerClientConfigDto TunnelerClientConfigDTO
+	GenericFromObject(responseData, &tunnelerClientConfigDto)
+	resourceState := tunnelerClientConfigDto.ConvertToZitiResourceModel(ctx)
+
+	state.Hostname = resourceState.Hostname
+	state.Port = resourceState.Port
+
+	state.ID = types.StringValue(*configList.BaseEntity.ID)
+	state.Name = types.StringPointerValue(configList.Name)
+	state.ConfigTypeID = types.StringValue(*configList.ConfigTypeID)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/enrollment"
+	"github.com/openziti/edge-api/rest_management_api_client/identity"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ZitiIdentityEnrollmentTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ZitiIdentityEnrollmentTokenEphemeralResource{}
+
+// defaultEnrollmentTokenValidity is used when the validity of a new enrollment is not configured.
+const defaultEnrollmentTokenValidity = "24h"
+
+func NewZitiIdentityEnrollmentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ZitiIdentityEnrollmentTokenEphemeralResource{}
+}
+
+// ZitiIdentityEnrollmentTokenEphemeralResource defines the ephemeral resource implementation.
+type ZitiIdentityEnrollmentTokenEphemeralResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiIdentityEnrollmentTokenEphemeralResourceModel describes the ephemeral resource data model.
+type ZitiIdentityEnrollmentTokenEphemeralResourceModel struct {
+	IdentityID types.String `tfsdk:"identity_id"`
+	Validity   types.String `tfsdk:"validity"`
+
+	EnrollmentID types.String `tfsdk:"enrollment_id"`
+	JWT          types.String `tfsdk:"jwt"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func (r *ZitiIdentityEnrollmentTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_enrollment_token"
+}
+
+func (r *ZitiIdentityEnrollmentTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An ephemeral resource to get a one-time token(ott) enrollment JWT of a Ziti identity without storing it in the state. A pending ott enrollment of the identity is reused, an expired one is refreshed, otherwise a new one is created. Terraform opens ephemeral resources during the plan too, so the enrollment is created by the plan already, and reused by the apply. It is not created while the identity is not known yet, eg before it is created",
+
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to enroll",
+				Required:            true,
+			},
+			"validity": schema.StringAttribute{
+				MarkdownDescription: "A duration a newly created or refreshed enrollment is valid for(default \"24h\")",
+				Optional:            true,
+			},
+			"enrollment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the enrollment",
+				Computed:            true,
+			},
+			"jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the identity with",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "An RFC3339 timestamp of when the enrollment expires",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ZitiIdentityEnrollmentTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiIdentityEnrollmentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ZitiIdentityEnrollmentTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do not create an enrollment for an identity which is yet to be created, the token stays unknown until the apply.
+	if data.IdentityID.IsUnknown() || data.Validity.IsUnknown() {
+		tflog.Debug(ctx, "Skipping the Ziti Enrollment until the configuration is known")
+		data.EnrollmentID = types.StringUnknown()
+		data.JWT = types.StringUnknown()
+		data.ExpiresAt = types.StringUnknown()
+
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	validityValue := defaultEnrollmentTokenValidity
+	if !data.Validity.IsNull() {
+		validityValue = data.Validity.ValueString()
+	}
+	validity, err := time.ParseDuration(validityValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("validity"), "Invalid Enrollment Duration", "validity must be a duration(eg \"24h\"): "+err.Error())
+		return
+	}
+
+	identityId := data.IdentityID.ValueString()
+	identityParams := identity.NewDetailIdentityParams()
+	identityParams.ID = identityId
+	identityDetail, err := r.client.API.Identity.DetailIdentity(identityParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity from API",
+			"Could not read Ziti Identity "+identityId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Reuse a pending ott enrollment as long as it has not expired yet.
+	var pending *rest_model.IdentityEnrollmentsOtt
+	if enrollments := identityDetail.Payload.Data.Enrollment; enrollments != nil {
+		pending = enrollments.Ott
+	}
+	if pending != nil && pending.JWT != "" && time.Now().Before(time.Time(pending.ExpiresAt)) {
+		tflog.Debug(ctx, "Reusing a pending ott enrollment of Ziti Identity "+identityId)
+		data.EnrollmentID = types.StringValue(pending.ID)
+		data.JWT = types.StringValue(pending.JWT)
+		data.ExpiresAt = types.StringValue(time.Time(pending.ExpiresAt).UTC().Format(time.RFC3339))
+
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	expiresAt := strfmt.DateTime(time.Now().Add(validity).UTC().Truncate(time.Second))
+	var enrollmentId string
+	if pending != nil {
+		// An identity has a single ott enrollment, so an expired one is refreshed rather than another one created.
+		enrollmentId = pending.ID
+		params := enrollment.NewRefreshEnrollmentParams()
+		params.ID = enrollmentId
+		params.Refresh = &rest_model.EnrollmentRefresh{
+			ExpiresAt: &expiresAt,
+		}
+
+		tflog.Debug(ctx, "Assigned all the params. Making RefreshEnrollment req")
+
+		_, err = r.client.API.Enrollment.RefreshEnrollment(params, nil)
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			resp.Diagnostics.AddError(
+				"Error Refreshing Ziti Enrollment from API",
+				"Could not refresh Ziti Enrollment "+enrollmentId+" of identity "+identityId+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		method := "ott"
+		params := enrollment.NewCreateEnrollmentParams()
+		params.Enrollment = &rest_model.EnrollmentCreate{
+			IdentityID: &identityId,
+			Method:     &method,
+			ExpiresAt:  &expiresAt,
+		}
+
+		tflog.Debug(ctx, "Assigned all the params. Making CreateEnrollment req")
+
+		created, err := r.client.API.Enrollment.CreateEnrollment(params, nil)
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			resp.Diagnostics.AddError(
+				"Error Creating Ziti Enrollment from API",
+				"Could not create Ziti Enrollment for identity "+identityId+": "+err.Error(),
+			)
+			return
+		}
+		enrollmentId = created.Payload.Data.ID
+	}
+
+	detailParams := enrollment.NewDetailEnrollmentParams()
+	detailParams.ID = enrollmentId
+	detail, err := r.client.API.Enrollment.DetailEnrollment(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Enrollment from API",
+			"Could not read Ziti Enrollment "+detailParams.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.EnrollmentID = types.StringValue(detailParams.ID)
+	data.JWT = types.StringValue(detail.Payload.Data.JWT)
+	data.ExpiresAt = types.StringValue(time.Time(expiresAt).Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZitiIdentityEnrollmentTokenEphemeralResourceOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		jwt       string
+		refreshed bool
+	}{
+		{name: "pending enrollment", expiresAt: time.Now().Add(time.Hour), jwt: "pending-jwt", refreshed: false},
+		{name: "expired enrollment", expiresAt: time.Now().Add(-time.Hour), jwt: "refreshed-jwt", refreshed: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			controller := newFakeController(t)
+			controller.Mux.HandleFunc("GET /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, map[string]any{
+					"data": map[string]any{
+						"id":   "identity-id",
+						"name": "identity",
+						"enrollment": map[string]any{
+							"ott": map[string]any{
+								"id":        "enrollment-id",
+								"jwt":       "pending-jwt",
+								"expiresAt": strfmt.DateTime(test.expiresAt).String(),
+							},
+						},
+					},
+					"meta": map[string]any{},
+				})
+			})
+			refreshes := make(chan map[string]any, 1)
+			controller.Mux.HandleFunc("POST /edge/management/v1/enrollments/enrollment-id/refresh", func(w http.ResponseWriter, r *http.Request) {
+				var refresh map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&refresh); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				refreshes <- refresh
+				writeJson(w, http.StatusOK, map[string]any{
+					"data": map[string]any{"id": "enrollment-id", "_links": map[string]any{}},
+					"meta": map[string]any{},
+				})
+			})
+			controller.Mux.HandleFunc("GET /edge/management/v1/enrollments/enrollment-id", func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, map[string]any{
+					"data": map[string]any{"id": "enrollment-id", "method": "ott", "jwt": "refreshed-jwt"},
+					"meta": map[string]any{},
+				})
+			})
+			var creates atomic.Int32
+			controller.Mux.HandleFunc("POST /edge/management/v1/enrollments", func(w http.ResponseWriter, r *http.Request) {
+				creates.Add(1)
+				writeApiError(w, http.StatusConflict, "ENROLLMENT_EXISTS")
+			})
+
+			r := &ZitiIdentityEnrollmentTokenEphemeralResource{client: controller.ManagementApiClient(t)}
+			schemaResp := &ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			config := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			require.False(t, config.Set(ctx, &ZitiIdentityEnrollmentTokenEphemeralResourceModel{
+				IdentityID:   types.StringValue("identity-id"),
+				Validity:     types.StringValue("48h"),
+				EnrollmentID: types.StringNull(),
+				JWT:          types.StringNull(),
+				ExpiresAt:    types.StringNull(),
+			}).HasError())
+			resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+
+			r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config(config)}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var result ZitiIdentityEnrollmentTokenEphemeralResourceModel
+			require.False(t, resp.Result.Get(ctx, &result).HasError())
+			assert.Equal(t, "enrollment-id", result.EnrollmentID.ValueString())
+			assert.Equal(t, test.jwt, result.JWT.ValueString())
+			assert.EqualValues(t, 0, creates.Load())
+
+			if !test.refreshed {
+				assert.Len(t, refreshes, 0)
+				return
+			}
+			require.Len(t, refreshes, 1)
+			refresh := <-refreshes
+			expiresAt, err := time.Parse(time.RFC3339, refresh["expiresAt"].(string))
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now().Add(48*time.Hour), expiresAt, time.Minute)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ValidateJsonSchema validates JSON encoded data against a JSON schema with the library a controller validates configs with.
+// It returns a list of violations, each prefixed with a path of the offending value.
+func ValidateJsonSchema(schema map[string]interface{}, data string) ([]string, error) {
+	result, err := gojsonschema.Validate(gojsonschema.NewGoLoader(schema), gojsonschema.NewStringLoader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	for _, violation := range result.Errors() {
+		violations = append(violations, violation.String())
+	}
+	return violations, nil
+}
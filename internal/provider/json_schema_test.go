@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateJsonSchema(t *testing.T) {
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"required":             []interface{}{"protocols"},
+		"additionalProperties": false,
+		"properties": map[string]interface{}{
+			"protocols": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"enum": []interface{}{"tcp", "udp"}},
+			},
+			"port": map[string]interface{}{"type": "integer", "minimum": 0.0, "maximum": 65535.0},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		data       string
+		violations []string
+	}{
+		{
+			name: "valid",
+			data: `{"protocols": ["tcp", "udp"], "port": 443}`,
+		},
+		{
+			name: "violations",
+			data: `{"protocols": ["icmp"], "port": 70000, "address": "localhost"}`,
+			violations: []string{
+				"(root): Additional property address is not allowed",
+				`protocols.0: protocols.0 must be one of the following: "tcp", "udp"`,
+				"port: Must be less than or equal to 65535",
+			},
+		},
+		{
+			name: "missing property and wrong type",
+			data: `{"port": 1.5}`,
+			violations: []string{
+				"(root): protocols is required",
+				"port: Invalid type. Expected: integer, given: number",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			violations, err := ValidateJsonSchema(schema, test.data)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.violations, violations)
+		})
+	}
+
+	_, err := ValidateJsonSchema(schema, `{"protocols": [`)
+	assert.Error(t, err)
+}
+
+func TestDecodeJson(t *testing.T) {
+	value, err := DecodeJson(`{"id": 9007199254740993, "ratio": 0.5}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": json.Number("9007199254740993"), "ratio": json.Number("0.5")}, value)
+
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 9007199254740993, "ratio": 0.5}`, string(encoded))
+
+	_, err = DecodeJson(`{"id": 1} {"id": 2}`)
+	assert.Error(t, err)
+	_, err = DecodeJson(`{"id": `)
+	assert.Error(t, err)
+}
+
+func TestNativeJsonToTerraformValueKeepsLargeIntegers(t *testing.T) {
+	value, diags := NativeJsonToTerraformValue(context.Background(), json.Number("9007199254740993"))
+	require.False(t, diags.HasError())
+
+	expected, _ := new(big.Int).SetString("9007199254740993", 10)
+	number, ok := value.(types.Number)
+	require.True(t, ok)
+	actual, _ := number.ValueBigFloat().Int(nil)
+	assert.Equal(t, expected, actual)
+}
@@ -8,16 +8,24 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"crypto/x509"
-	"encoding/base64"
-	"github.com/fullsailor/pkcs7"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openziti/sdk-golang/edge-apis"
@@ -27,6 +35,7 @@ import (
 // Ensure ZitiProvider satisfies various provider interfaces.
 var _ provider.Provider = &ZitiProvider{}
 var _ provider.ProviderWithFunctions = &ZitiProvider{}
+var _ provider.ProviderWithEphemeralResources = &ZitiProvider{}
 
 // ZitiProvider defines the provider implementation.
 type ZitiProvider struct {
@@ -42,6 +51,28 @@ type ZitiProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	CaPool   types.String `tfsdk:"capool"`
+	CertPem  types.String `tfsdk:"cert_pem"`
+	KeyPem   types.String `tfsdk:"key_pem"`
+	CertFile types.String `tfsdk:"cert_file"`
+	KeyFile  types.String `tfsdk:"key_file"`
+	Jwt      types.String `tfsdk:"jwt"`
+	JwtFile  types.String `tfsdk:"jwt_file"`
+
+	IdentityFile types.String `tfsdk:"identity_file"`
+	IdentityJson types.String `tfsdk:"identity_json"`
+
+	CaPoolFile       types.String `tfsdk:"capool_file"`
+	TrustWellKnownCa types.Bool   `tfsdk:"trust_well_known_ca"`
+
+	TotpSecret types.String `tfsdk:"totp_secret"`
+	TotpCode   types.String `tfsdk:"totp_code"`
+
+	Endpoints      types.List   `tfsdk:"mgmt_endpoints"`
+	EndpointsOrder types.String `tfsdk:"mgmt_endpoints_order"`
+
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
 }
 
 func (p *ZitiProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,6 +87,22 @@ func (p *ZitiProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				MarkdownDescription: "An endpoint pointing to Ziti Edge Management API URL",
 				Optional:            true,
 			},
+			"mgmt_endpoints": schema.ListAttribute{
+				MarkdownDescription: "Endpoints pointing to Ziti Edge Management API URLs of the controllers of a HA cluster. Requests fail over to the next controller when one cannot be reached. Conflicts with mgmt_endpoint",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"mgmt_endpoints_order": schema.StringAttribute{
+				MarkdownDescription: "An order to try the controllers of mgmt_endpoints in, either `ordered`(as listed) or `random`. Defaults to `ordered`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(endpointsOrderOrdered, endpointsOrderRandom),
+				},
+			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "A username of an identity that is able to perform admin actions",
 				Optional:            true,
@@ -66,13 +113,90 @@ func (p *ZitiProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Sensitive:           true,
 			},
 			"capool": schema.StringAttribute{
-				MarkdownDescription: "A base64 encoded CA Pool of the Edge Management API.",
+				MarkdownDescription: "A CA Pool of the Edge Management API, either a PEM bundle or a PKCS#7/DER structure(optionally base64 encoded, as served by the controller at .well-known/est/cacerts). The format is detected automatically",
+				Optional:            true,
+			},
+			"capool_file": schema.StringAttribute{
+				MarkdownDescription: "A path to a file with a CA Pool of the Edge Management API, in any of the formats accepted by capool. Conflicts with capool",
+				Optional:            true,
+			},
+			"trust_well_known_ca": schema.BoolAttribute{
+				MarkdownDescription: "Retrieve the CA Pool from the .well-known/est/cacerts endpoint of the controller and trust it without verification. " +
+					"Only used when no CA Pool is provided by capool, capool_file or an identity file. Defaults to false",
+				Optional: true,
+			},
+			"cert_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded client certificate(optionally followed by its chain) of an identity that is able to perform admin actions. Used instead of username and password",
+				Optional:            true,
+			},
+			"key_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded private key of the client certificate",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"cert_file": schema.StringAttribute{
+				MarkdownDescription: "A path to a file with a PEM encoded client certificate. Conflicts with cert_pem",
+				Optional:            true,
+			},
+			"key_file": schema.StringAttribute{
+				MarkdownDescription: "A path to a file with a PEM encoded private key of the client certificate. Conflicts with key_pem",
+				Optional:            true,
+			},
+			"jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT issued by an external JWT signer(eg an OIDC token of a CI runner), which is used to authenticate instead of username and password",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"jwt_file": schema.StringAttribute{
+				MarkdownDescription: "A path to a file with a JWT issued by an external JWT signer. The file is read again whenever it changes, so that a rotated token is used to authenticate again. Conflicts with jwt",
 				Optional:            true,
 			},
+			"identity_file": schema.StringAttribute{
+				MarkdownDescription: "A path to a standard Ziti identity file(ztAPI, id.cert, id.key, id.ca) of an identity that is able to perform admin actions. " +
+					"The management endpoint, the CA pool and the client certificate are derived from it, unless set explicitly",
+				Optional: true,
+			},
+			"identity_json": schema.StringAttribute{
+				MarkdownDescription: "A content of a standard Ziti identity file. Conflicts with identity_file",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"totp_secret": schema.StringAttribute{
+				MarkdownDescription: "A base32 encoded TOTP secret of the identity, which is used to generate RFC 6238 codes when its auth policy requires TOTP. Conflicts with totp_code",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times a request is sent again when it fails while the controllers are unavailable(502, 503, connection refused or reset), eg restarting or electing a raft leader. " +
+					"Only idempotent requests(reads, replaces and deletes, but not patches), and creates of entities with unique names are retried. A create is checked by name before trying again, and an entity created since the first attempt is adopted. 0 disables retries. Defaults to 3",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				MarkdownDescription: "A duration to wait before the first retry(eg \"1s\"), which doubles with every following one. Defaults to 1s",
+				Optional:            true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				MarkdownDescription: "A maximum duration to wait between retries(eg \"30s\"). Defaults to 30s",
+				Optional:            true,
+			},
+			"totp_code": schema.StringAttribute{
+				MarkdownDescription: "A TOTP code of the identity, when its auth policy requires TOTP. A code is only valid once and for a short time, prefer totp_secret for applies that might need to authenticate again",
+				Optional:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
 
+// Orders to try the controllers of mgmt_endpoints in.
+const (
+	endpointsOrderOrdered = "ordered"
+	endpointsOrderRandom  = "random"
+)
+
 func emptyTotpCallback(ch chan string) {
 	ch <- "" // Send an empty string
 	close(ch)
@@ -114,17 +238,97 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.Endpoints.IsUnknown() || config.EndpointsOrder.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mgmt_endpoints"),
+			"Unknown Ziti Edge Management API URLs",
+			"The provider cannot create the Ziti Edge API client as there is an unknown configuration value for mgmt_endpoints or mgmt_endpoints_order. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZITI_EDGE_MGMT_URLS and ZITI_EDGE_MGMT_URLS_ORDER environment variables.",
+		)
+	}
+
+	for _, attribute := range []struct {
+		name   string
+		value  types.String
+		envVar string
+	}{
+		{"cert_pem", config.CertPem, "ZITI_EDGE_MGMT_CERT_PEM"},
+		{"key_pem", config.KeyPem, "ZITI_EDGE_MGMT_KEY_PEM"},
+		{"cert_file", config.CertFile, "ZITI_EDGE_MGMT_CERT_FILE"},
+		{"key_file", config.KeyFile, "ZITI_EDGE_MGMT_KEY_FILE"},
+		{"jwt", config.Jwt, "ZITI_EDGE_MGMT_JWT"},
+		{"jwt_file", config.JwtFile, "ZITI_EDGE_MGMT_JWT_FILE"},
+		{"identity_file", config.IdentityFile, "ZITI_EDGE_MGMT_IDENTITY_FILE"},
+		{"identity_json", config.IdentityJson, "ZITI_EDGE_MGMT_IDENTITY_JSON"},
+		{"totp_secret", config.TotpSecret, "ZITI_EDGE_MGMT_TOTP_SECRET"},
+		{"totp_code", config.TotpCode, "ZITI_EDGE_MGMT_TOTP_CODE"},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown Ziti Edge Management API Credentials",
+				"The provider cannot create the Ziti Edge API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the "+attribute.envVar+" environment variable.",
+			)
+		}
+	}
+
+	if config.MaxRetries.IsUnknown() || config.RetryMinBackoff.IsUnknown() || config.RetryMaxBackoff.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Ziti Edge Management API Retry Settings",
+			"The provider cannot create the Ziti Edge API client as there is an unknown configuration value for max_retries, retry_min_backoff or retry_max_backoff. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZITI_EDGE_MGMT_MAX_RETRIES, ZITI_EDGE_MGMT_RETRY_MIN_BACKOFF or ZITI_EDGE_MGMT_RETRY_MAX_BACKOFF environment variables.",
+		)
+	}
+
+	if config.CaPoolFile.IsUnknown() || config.TrustWellKnownCa.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Ziti Edge Management API CA Pool",
+			"The provider cannot create the Ziti Edge API client as there is an unknown configuration value for capool_file or trust_well_known_ca. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZITI_EDGE_MGMT_CAPOOL_FILE or ZITI_EDGE_MGMT_TRUST_WELL_KNOWN_CA environment variables.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	endpoint := os.Getenv("ZITI_EDGE_MGMT_URL")
+	var endpoints []string
+	if envEndpoints := os.Getenv("ZITI_EDGE_MGMT_URLS"); envEndpoints != "" {
+		for _, envEndpoint := range strings.Split(envEndpoints, ",") {
+			endpoints = append(endpoints, strings.TrimSpace(envEndpoint))
+		}
+	}
+	endpointsOrder := os.Getenv("ZITI_EDGE_MGMT_URLS_ORDER")
 	username := os.Getenv("ZITI_EDGE_MGMT_USERNAME")
 	password := os.Getenv("ZITI_EDGE_MGMT_PASSWORD")
 	capool := os.Getenv("ZITI_EDGE_MGMT_CAPOOL")
-
-	if !config.Endpoint.IsNull() {
+	capoolFile := os.Getenv("ZITI_EDGE_MGMT_CAPOOL_FILE")
+	trustWellKnownCa, _ := strconv.ParseBool(os.Getenv("ZITI_EDGE_MGMT_TRUST_WELL_KNOWN_CA"))
+	certPem := os.Getenv("ZITI_EDGE_MGMT_CERT_PEM")
+	keyPem := os.Getenv("ZITI_EDGE_MGMT_KEY_PEM")
+	certFile := os.Getenv("ZITI_EDGE_MGMT_CERT_FILE")
+	keyFile := os.Getenv("ZITI_EDGE_MGMT_KEY_FILE")
+	jwt := os.Getenv("ZITI_EDGE_MGMT_JWT")
+	jwtFile := os.Getenv("ZITI_EDGE_MGMT_JWT_FILE")
+	identityFile := os.Getenv("ZITI_EDGE_MGMT_IDENTITY_FILE")
+	identityJson := os.Getenv("ZITI_EDGE_MGMT_IDENTITY_JSON")
+	totpSecret := os.Getenv("ZITI_EDGE_MGMT_TOTP_SECRET")
+	totpCode := os.Getenv("ZITI_EDGE_MGMT_TOTP_CODE")
+	maxRetries := os.Getenv("ZITI_EDGE_MGMT_MAX_RETRIES")
+	retryMinBackoff := os.Getenv("ZITI_EDGE_MGMT_RETRY_MIN_BACKOFF")
+	retryMaxBackoff := os.Getenv("ZITI_EDGE_MGMT_RETRY_MAX_BACKOFF")
+
+	// Either of the endpoint settings in the configuration overrides both of the environment variables.
+	if !config.Endpoint.IsNull() || !config.Endpoints.IsNull() {
 		endpoint = config.Endpoint.ValueString()
+		endpoints = nil
+		resp.Diagnostics.Append(config.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+	}
+
+	if !config.EndpointsOrder.IsNull() {
+		endpointsOrder = config.EndpointsOrder.ValueString()
 	}
 
 	if !config.Username.IsNull() {
@@ -139,20 +343,192 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		capool = config.CaPool.ValueString()
 	}
 
+	if !config.CaPoolFile.IsNull() {
+		capoolFile = config.CaPoolFile.ValueString()
+	}
+
+	if !config.TrustWellKnownCa.IsNull() {
+		trustWellKnownCa = config.TrustWellKnownCa.ValueBool()
+	}
+
+	if !config.CertPem.IsNull() {
+		certPem = config.CertPem.ValueString()
+	}
+
+	if !config.KeyPem.IsNull() {
+		keyPem = config.KeyPem.ValueString()
+	}
+
+	if !config.CertFile.IsNull() {
+		certFile = config.CertFile.ValueString()
+	}
+
+	if !config.KeyFile.IsNull() {
+		keyFile = config.KeyFile.ValueString()
+	}
+
+	if !config.Jwt.IsNull() {
+		jwt = config.Jwt.ValueString()
+	}
+
+	if !config.JwtFile.IsNull() {
+		jwtFile = config.JwtFile.ValueString()
+	}
+
+	if !config.IdentityFile.IsNull() {
+		identityFile = config.IdentityFile.ValueString()
+	}
+
+	if !config.IdentityJson.IsNull() {
+		identityJson = config.IdentityJson.ValueString()
+	}
+
+	if !config.TotpSecret.IsNull() {
+		totpSecret = config.TotpSecret.ValueString()
+	}
+
+	if !config.TotpCode.IsNull() {
+		totpCode = config.TotpCode.ValueString()
+	}
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries = strconv.FormatInt(config.MaxRetries.ValueInt64(), 10)
+	}
+
+	if !config.RetryMinBackoff.IsNull() {
+		retryMinBackoff = config.RetryMinBackoff.ValueString()
+	}
+
+	if !config.RetryMaxBackoff.IsNull() {
+		retryMaxBackoff = config.RetryMaxBackoff.ValueString()
+	}
+
+	// An identity file provides the endpoint, the CA pool and the client certificate at once.
+	var identityCaPem string
+	if zitiIdentity := p.readIdentityFile(identityFile, identityJson, &resp.Diagnostics); zitiIdentity != nil {
+		if certPem != "" || keyPem != "" || certFile != "" || keyFile != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identity_file"),
+				"Conflicting Ziti Edge Management API Client Certificate Settings",
+				"An identity file provides the client certificate, so cert_pem, cert_file, key_pem and key_file(in the configuration or the environment) cannot be set along with it.",
+			)
+			return
+		}
+		var err error
+		if endpoint == "" && len(endpoints) == 0 {
+			endpoints, err = zitiIdentity.ManagementEndpoints()
+		}
+		if err == nil {
+			certPem, err = zitiIdentity.CertPem()
+		}
+		if err == nil {
+			keyPem, err = zitiIdentity.KeyPem()
+		}
+		if err == nil {
+			identityCaPem, err = zitiIdentity.CaPem()
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identity_file"),
+				"Unable to load the Ziti identity file",
+				"The provider cannot load the identity file: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	certPem, keyPem = p.readClientCertificateFiles(certPem, keyPem, certFile, keyFile, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if endpoint != "" && len(endpoints) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mgmt_endpoints"),
+			"Conflicting Ziti Edge Management API URL Settings",
+			"Both mgmt_endpoint and mgmt_endpoints are set(in the configuration, or ZITI_EDGE_MGMT_URL and ZITI_EDGE_MGMT_URLS in the environment), set only one of them.",
+		)
+	}
+	if endpoint != "" {
+		endpoints = []string{endpoint}
+	}
+
+	if endpointsOrder != "" && endpointsOrder != endpointsOrderOrdered && endpointsOrder != endpointsOrderRandom {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mgmt_endpoints_order"),
+			"Invalid Ziti Edge Management API URLs Order",
+			"The order to try the controllers in must be either "+endpointsOrderOrdered+" or "+endpointsOrderRandom+", got "+endpointsOrder+".",
+		)
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if endpoint == "" {
+	if len(endpoints) == 0 {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
 			"Missing Ziti Edge Management API URL",
 			"The provider cannot create the Ziti Edge Management API client as there is a missing or empty value for the HashiCups API host. "+
-				"Set the host value in the configuration or use the ZITI_EDGE_MGMT_URL environment variable. "+
+				"Set mgmt_endpoint or mgmt_endpoints in the configuration, or use the ZITI_EDGE_MGMT_URL or ZITI_EDGE_MGMT_URLS environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if username == "" {
+	var apiUrls []*url.URL
+	for _, apiEndpoint := range endpoints {
+		apiUrl, err := url.Parse(apiEndpoint)
+		if err != nil || apiUrl.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mgmt_endpoints"),
+				"Invalid Ziti Edge Management API URL",
+				"The provider cannot parse "+apiEndpoint+" as an URL of the Ziti Edge Management API, eg https://localhost:1280/edge/management/v1.",
+			)
+			continue
+		}
+		apiUrls = append(apiUrls, apiUrl)
+	}
+
+	useUpdb := username != "" || password != ""
+	useCert := certPem != "" || keyPem != ""
+	useJwt := jwt != "" || jwtFile != ""
+
+	var authMethods []string
+	for method, used := range map[string]bool{"username/password": useUpdb, "client certificate": useCert, "JWT": useJwt} {
+		if used {
+			authMethods = append(authMethods, method)
+		}
+	}
+	sort.Strings(authMethods)
+
+	credentialsHelp := "Set exactly one of: username and password(ZITI_EDGE_MGMT_USERNAME, ZITI_EDGE_MGMT_PASSWORD), " +
+		"a client certificate and its key(cert_pem/cert_file and key_pem/key_file, or ZITI_EDGE_MGMT_CERT_PEM/ZITI_EDGE_MGMT_CERT_FILE and ZITI_EDGE_MGMT_KEY_PEM/ZITI_EDGE_MGMT_KEY_FILE), " +
+		"an identity file(identity_file/identity_json, or ZITI_EDGE_MGMT_IDENTITY_FILE/ZITI_EDGE_MGMT_IDENTITY_JSON), " +
+		"or a JWT(jwt/jwt_file, or ZITI_EDGE_MGMT_JWT/ZITI_EDGE_MGMT_JWT_FILE)."
+
+	if len(authMethods) > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting Ziti Edge Management API Credentials",
+			"The provider cannot decide how to authenticate to the Ziti Edge Management API as several kinds of credentials are set("+strings.Join(authMethods, ", ")+"), in the configuration or the environment. "+
+				credentialsHelp,
+		)
+	}
+
+	if len(authMethods) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Ziti Edge Management API Credentials",
+			"The provider cannot authenticate to the Ziti Edge Management API as there are no credentials set. "+credentialsHelp,
+		)
+	}
+
+	if jwt != "" && jwtFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("jwt_file"),
+			"Conflicting Ziti Edge Management API JWT Settings",
+			"Both jwt and jwt_file are set(in the configuration or the environment), set only one of them.",
+		)
+	}
+
+	if useUpdb && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Ziti Edge Management API Username",
@@ -162,7 +538,7 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	if password == "" {
+	if useUpdb && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing Ziti Edge Management API Password",
@@ -172,44 +548,120 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	if capool == "" {
-		resp.Diagnostics.AddAttributeWarning(
-			path.Root("capool"),
-			"Missing CA Pool value to verify, will retrieve CA Pool and trust it implicitly!",
-			"Make sure to provide CA Pool manually in configuration or use the ZITI_EDGE_MGMT_CAPOOL environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+	if useCert && certPem == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cert_pem"),
+			"Missing Ziti Edge Management API Client Certificate",
+			"The provider cannot authenticate with a client certificate as there is a private key set, but no certificate. "+
+				"Set cert_pem or cert_file in the configuration, or use the ZITI_EDGE_MGMT_CERT_PEM or ZITI_EDGE_MGMT_CERT_FILE environment variables.",
+		)
+	}
+
+	if useCert && keyPem == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_pem"),
+			"Missing Ziti Edge Management API Client Certificate Key",
+			"The provider cannot authenticate with a client certificate as there is a certificate set, but no private key. "+
+				"Set key_pem or key_file in the configuration, or use the ZITI_EDGE_MGMT_KEY_PEM or ZITI_EDGE_MGMT_KEY_FILE environment variables.",
 		)
+	}
 
+	if totpSecret != "" && totpCode != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp_code"),
+			"Conflicting Ziti Edge Management API TOTP Settings",
+			"Both totp_secret and totp_code are set(in the configuration or the environment), set only one of them.",
+		)
+	}
+
+	totpCallback, err := newTotpCallback(totpSecret, totpCode)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp_secret"),
+			"Invalid Ziti Edge Management API TOTP Secret",
+			"The provider cannot generate TOTP codes from totp_secret(or ZITI_EDGE_MGMT_TOTP_SECRET): "+err.Error(),
+		)
+	}
+
+	retry := p.readRetryPolicy(maxRetries, retryMinBackoff, retryMaxBackoff, &resp.Diagnostics)
+
+	if capool != "" && capoolFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("capool_file"),
+			"Conflicting Ziti Edge Management API CA Pool Settings",
+			"Both capool and capool_file are set(in the configuration or the environment), set only one of them.",
+		)
+	}
+
+	if capool == "" && capoolFile == "" && identityCaPem == "" && !trustWellKnownCa {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("capool"),
+			"Missing Ziti Edge Management API CA Pool",
+			"The provider cannot verify the Ziti Edge Management API as there is no CA Pool set. "+
+				"Set capool or capool_file in the configuration, or use the ZITI_EDGE_MGMT_CAPOOL or ZITI_EDGE_MGMT_CAPOOL_FILE environment variables. "+
+				"To retrieve the CA Pool from the controller and trust it implicitly instead, set trust_well_known_ca to true or ZITI_EDGE_MGMT_TRUST_WELL_KNOWN_CA to true.",
+		)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "ziti_mgmt_endpoint", endpoint)
+	ctx = tflog.SetField(ctx, "ziti_mgmt_endpoints", strings.Join(endpoints, ","))
 	ctx = tflog.SetField(ctx, "ziti_username", username)
 	ctx = tflog.SetField(ctx, "ziti_password", password)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ziti_password")
 
 	tflog.Debug(ctx, "Creating Ziti client")
 
-	apiUrl, _ := url.Parse(endpoint)
-
-	// Note that GetControllerWellKnownCaPool() does not verify the authenticity of the controller, it is assumed
-	// this is handled in some other way.
 	var caPool *x509.CertPool
-	if capool == "" {
-		// Parse the full URL
-		parsedUrl, err := url.Parse(endpoint)
+	if capool != "" || capoolFile != "" {
+		var err error
+		caPoolAttribute := "capool"
+		caPoolData := []byte(capool)
+		if capoolFile != "" {
+			caPoolAttribute = "capool_file"
+			caPoolData, err = os.ReadFile(capoolFile)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(caPoolAttribute),
+					"Unable to read the Ziti Edge Management API CA Pool file",
+					"The provider cannot read "+capoolFile+": "+err.Error(),
+				)
+				return
+			}
+		}
+		caPool, err = ParseCaPool(caPoolData)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to parse an endpoint url, make sure its a valid url!",
-				"The provider cannot parse an endpoint pointing to a edge management api. Make sure its a valid rfc complaint url",
+			resp.Diagnostics.AddAttributeError(
+				path.Root(caPoolAttribute),
+				"Unable to parse the Ziti Edge Management API CA Pool",
+				"The provider cannot parse the CA Pool. Make sure it is a PEM bundle, or a PKCS#7 or DER structure, optionally base64 encoded: "+err.Error(),
 			)
 			return
 		}
-		// Construct the base URL
-		baseUrl := fmt.Sprintf("%s://%s", parsedUrl.Scheme, parsedUrl.Host)
+	} else if identityCaPem != "" {
+		caCerts, err := ParseCertificatesPem(identityCaPem)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identity_file"),
+				"Unable to parse the CA of the Ziti identity file",
+				"The provider cannot parse id.ca of the identity file: "+err.Error(),
+			)
+			return
+		}
+		caPool = x509.NewCertPool()
+		for _, cert := range caCerts {
+			caPool.AddCert(cert)
+		}
+	} else {
+		// Note that GetControllerWellKnownCaPool() does not verify the authenticity of the controller, which is
+		// why it is used only when trust_well_known_ca is explicitly set.
+		tflog.Warn(ctx, "Trusting the CA Pool retrieved from the well-known endpoint of the Ziti controller without verification")
+
+		// Construct the base URL of the first controller
+		baseUrl := fmt.Sprintf("%s://%s", apiUrls[0].Scheme, apiUrls[0].Host)
+		var err error
 		caPool, err = ziti.GetControllerWellKnownCaPool(baseUrl)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -219,46 +671,51 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			return
 
 		}
-	} else {
-		certData, err := base64.StdEncoding.DecodeString(capool)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var credentials edge_apis.Credentials
+	var rotatingJwt *rotatingJwtFile
+	if useJwt && jwtFile != "" {
+		var err error
+		rotatingJwt, err = newRotatingJwtFile(jwtFile, caPool)
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("capool"),
-				"Unable to decode capool value using base64!",
-				"The provider cannot decode base64-encoded capool value. Make sure the capool value is an actual base64 encoded capool",
+				path.Root("jwt_file"),
+				"Unable to read the Ziti Edge Management API JWT file",
+				"The provider cannot read a JWT from "+jwtFile+": "+err.Error(),
 			)
 			return
 		}
-		certs, err := pkcs7.Parse(certData)
-
+		credentials = rotatingJwt.credentials()
+	} else if useJwt {
+		jwtCredentials := edge_apis.NewJwtCredentials(jwt)
+		jwtCredentials.CaPool = caPool
+		credentials = jwtCredentials
+	} else if useCert {
+		certCredentials, err := newCertCredentials(certPem, keyPem)
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("capool"),
-				"Unable to parse decoded base64 capool into an actual pkcs7 structure.",
-				"The provider cannot parse decoded base64 capool into a pkcs7 structure. Make sure that the encoded base64 value is the actual chain",
+				path.Root("cert_pem"),
+				"Unable to parse the Ziti Edge Management API client certificate",
+				"The provider cannot parse the client certificate or its private key. Make sure both are PEM encoded: "+err.Error(),
 			)
+			return
 		}
-
-		caPool = x509.NewCertPool()
-		for _, cert := range certs.Certificates {
-			caPool.AddCert(cert)
-		}
-
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
+		certCredentials.CaPool = caPool
+		credentials = certCredentials
+	} else {
+		updbCredentials := edge_apis.NewUpdbCredentials(username, password)
+		updbCredentials.CaPool = caPool
+		credentials = updbCredentials
 	}
 
-	credentials := edge_apis.NewUpdbCredentials(username, password)
-	credentials.CaPool = caPool
-
-	var apiUrls []*url.URL
-	apiUrls = append(apiUrls, apiUrl)
-
 	//Note: the CA pool can be provided here or during the Authenticate(<creds>) call. It is allowed here to enable
 	//      calls to REST API endpoints that do not require authentication.
-	managementClient := edge_apis.NewManagementApiClient(apiUrls, credentials.GetCaPool(), emptyTotpCallback)
+	managementClient := edge_apis.NewManagementApiClient(apiUrls, credentials.GetCaPool(), totpCallback)
 
 	//"configTypes" are string identifiers of configuration that can be requested by clients. Developers may
 	//specify their own in order to provide distributed identity and/or service specific configurations.
@@ -267,11 +724,18 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	//Example: configTypes = []string{"myCustomAppConfigType"}
 	var configTypes []string
 
-	_, err := managementClient.Authenticate(credentials, configTypes)
+	// The requests of the client are sent through a transport which fails over between the controllers.
+	transport := installManagementTransport(ctx, managementClient, apiUrls, endpointsOrder == endpointsOrderRandom, credentials, configTypes, totpCallback)
+	if rotatingJwt != nil {
+		transport.refreshCredentials = rotatingJwt.refresh
+	}
+	transport.retry = retry
+
+	err = transport.connect()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client for Ziti Edge Management API",
-			"The provider cannot create a client for a Ziti Edge Management API",
+			"The provider cannot create a client for a Ziti Edge Management API: "+err.Error(),
 		)
 	}
 
@@ -281,22 +745,177 @@ func (p *ZitiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	resp.DataSourceData = managementClient
 	resp.ResourceData = managementClient
+	resp.EphemeralResourceData = managementClient
 
 	tflog.Info(ctx, "Configured Ziti Edge Management client", map[string]any{"success": true})
 }
 
+// readClientCertificateFiles returns the PEM contents of the client certificate and its key, reading them from files when paths are set.
+func (p *ZitiProvider) readClientCertificateFiles(certPem string, keyPem string, certFile string, keyFile string, diags *diag.Diagnostics) (string, string) {
+	for _, pair := range []struct {
+		pem  *string
+		file string
+		name string
+	}{
+		{&certPem, certFile, "cert"},
+		{&keyPem, keyFile, "key"},
+	} {
+		if pair.file == "" {
+			continue
+		}
+		if *pair.pem != "" {
+			diags.AddAttributeError(
+				path.Root(pair.name+"_file"),
+				"Conflicting Ziti Edge Management API Client Certificate Settings",
+				"Both "+pair.name+"_pem and "+pair.name+"_file are set(in the configuration or the environment), set only one of them.",
+			)
+			continue
+		}
+		content, err := os.ReadFile(pair.file)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(pair.name+"_file"),
+				"Unable to read the Ziti Edge Management API Client Certificate file",
+				"The provider cannot read "+pair.file+": "+err.Error(),
+			)
+			continue
+		}
+		*pair.pem = string(content)
+	}
+	return certPem, keyPem
+}
+
+// readIdentityFile reads and parses the identity file, or its inline content. It returns nil when neither is set.
+func (p *ZitiProvider) readIdentityFile(identityFile string, identityJson string, diags *diag.Diagnostics) *ZitiIdentityFile {
+	if identityFile == "" && identityJson == "" {
+		return nil
+	}
+	if identityFile != "" && identityJson != "" {
+		diags.AddAttributeError(
+			path.Root("identity_json"),
+			"Conflicting Ziti Identity File Settings",
+			"Both identity_file and identity_json are set(in the configuration or the environment), set only one of them.",
+		)
+		return nil
+	}
+
+	content := []byte(identityJson)
+	if identityFile != "" {
+		var err error
+		content, err = os.ReadFile(identityFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("identity_file"),
+				"Unable to read the Ziti identity file",
+				"The provider cannot read "+identityFile+": "+err.Error(),
+			)
+			return nil
+		}
+	}
+
+	zitiIdentity, err := ParseZitiIdentityFile(content)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("identity_file"),
+			"Unable to parse the Ziti identity file",
+			"The provider cannot parse the identity file: "+err.Error(),
+		)
+		return nil
+	}
+	return zitiIdentity
+}
+
+// readRetryPolicy parses the retry settings, falling back to the defaults for the ones which are not set.
+func (p *ZitiProvider) readRetryPolicy(maxRetries string, minBackoff string, maxBackoff string, diags *diag.Diagnostics) retryPolicy {
+	policy := retryPolicy{maxRetries: defaultMaxRetries}
+	if maxRetries != "" {
+		retries, err := strconv.Atoi(maxRetries)
+		if err != nil || retries < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Ziti Edge Management API Retry Settings",
+				"max_retries(or ZITI_EDGE_MGMT_MAX_RETRIES) must be a number that is at least 0, got "+maxRetries+".",
+			)
+		}
+		policy.maxRetries = retries
+	}
+
+	for _, setting := range []struct {
+		name     string
+		value    string
+		fallback string
+		duration *time.Duration
+	}{
+		{"retry_min_backoff", minBackoff, defaultRetryMinBackoff, &policy.minBackoff},
+		{"retry_max_backoff", maxBackoff, defaultRetryMaxBackoff, &policy.maxBackoff},
+	} {
+		value := setting.value
+		if value == "" {
+			value = setting.fallback
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration < 0 {
+			diags.AddAttributeError(
+				path.Root(setting.name),
+				"Invalid Ziti Edge Management API Retry Settings",
+				setting.name+" must be a duration(eg \"1s\"), got "+value+".",
+			)
+		}
+		*setting.duration = duration
+	}
+
+	if policy.minBackoff > policy.maxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry_max_backoff"),
+			"Invalid Ziti Edge Management API Retry Settings",
+			"retry_max_backoff must not be shorter than retry_min_backoff.",
+		)
+	}
+	return policy
+}
+
+// newCertCredentials creates credentials which authenticate with a PEM encoded client certificate and its key.
+func newCertCredentials(certPem string, keyPem string) (*edge_apis.CertCredentials, error) {
+	certs, err := ParseCertificatesPem(certPem)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParsePrivateKeyPem(keyPem)
+	if err != nil {
+		return nil, err
+	}
+	return edge_apis.NewCertCredentials(certs, key), nil
+}
+
 func (p *ZitiProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewZitiHostConfigResource,
+		NewZitiHostConfigV2Resource,
 		NewZitiInterceptConfigResource,
+		NewZitiTunnelerClientConfigResource,
+		NewZitiTunnelerServerConfigResource,
+		NewZitiProxyConfigResource,
+		NewZitiConfigTypeResource,
+		NewZitiConfigResource,
 
 		NewZitiServiceResource,
 		NewZitiIdentityResource,
+		NewZitiEnrollmentResource,
+		NewZitiEnrolledIdentityResource,
+		NewZitiAuthenticatorResource,
+		NewZitiIdentityServiceConfigResource,
 
 		NewZitiServicePolicyResource,
 		NewZitiServiceEdgeRouterPolicyResource,
 		NewZitiEdgeRouterPolicyResource,
 
+		NewZitiEdgeRouterResource,
+		NewZitiTransitRouterResource,
+		NewZitiAuthPolicyResource,
+		NewZitiExtJwtSignerResource,
+		NewZitiCertificateAuthorityResource,
+		NewZitiTerminatorResource,
+
 		NewZitiPostureMultiProcessResource,
 		NewZitiPostureProcessResource,
 		NewZitiPostureOperatingSystemResource,
@@ -311,14 +930,34 @@ func (p *ZitiProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewZitiHostConfigDataSource,
 		NewZitiHostConfigIdsDataSource,
 
+		NewZitiHostConfigV2DataSource,
+		NewZitiHostConfigV2IdsDataSource,
+
 		NewZitiInterceptConfigDataSource,
 		NewZitiInterceptConfigIdsDataSource,
 
+		NewZitiTunnelerClientConfigDataSource,
+		NewZitiTunnelerClientConfigIdsDataSource,
+
+		NewZitiTunnelerServerConfigDataSource,
+		NewZitiTunnelerServerConfigIdsDataSource,
+
+		NewZitiProxyConfigDataSource,
+		NewZitiProxyConfigIdsDataSource,
+
+		NewZitiConfigTypeDataSource,
+		NewZitiConfigTypeIdsDataSource,
+
+		NewZitiConfigDataSource,
+		NewZitiConfigIdsDataSource,
+
 		NewZitiServiceDataSource,
 		NewZitiServiceIdsDataSource,
 
 		NewZitiIdentityDataSource,
 		NewZitiIdentityIdsDataSource,
+		NewZitiIdentityAuthenticatorsDataSource,
+		NewZitiIdentityServiceConfigDataSource,
 
 		NewZitiServicePolicyDataSource,
 		NewZitiServicePolicyIdsDataSource,
@@ -329,6 +968,20 @@ func (p *ZitiProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewZitiEdgeRouterPolicyDataSource,
 		NewZitiEdgeRouterPolicyIdsDataSource,
 
+		NewZitiEdgeRouterDataSource,
+		NewZitiEdgeRouterIdsDataSource,
+
+		NewZitiTransitRouterDataSource,
+		NewZitiTransitRouterIdsDataSource,
+
+		NewZitiAuthPolicyDataSource,
+		NewZitiAuthPolicyIdsDataSource,
+
+		NewZitiExtJwtSignerDataSource,
+		NewZitiExtJwtSignerIdsDataSource,
+
+		NewZitiTerminatorsDataSource,
+
 		NewZitiPostureMultiProcessDataSource,
 		NewZitiPostureMultiProcessIdsDataSource,
 
@@ -349,6 +1002,12 @@ func (p *ZitiProvider) DataSources(ctx context.Context) []func() datasource.Data
 	}
 }
 
+func (p *ZitiProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewZitiIdentityEnrollmentTokenEphemeralResource,
+	}
+}
+
 func (p *ZitiProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{}
 }
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// ZitiIdentityFile is a standard Ziti identity file, as produced by enrolling an identity.
+type ZitiIdentityFile struct {
+	ZtAPI  string   `json:"ztAPI"`
+	ZtAPIs []string `json:"ztAPIs"`
+	ID     struct {
+		Key  string `json:"key"`
+		Cert string `json:"cert"`
+		CA   string `json:"ca"`
+	} `json:"id"`
+}
+
+// ParseZitiIdentityFile parses the JSON of an identity file.
+func ParseZitiIdentityFile(identityJson []byte) (*ZitiIdentityFile, error) {
+	var identityFile ZitiIdentityFile
+	if err := json.Unmarshal(identityJson, &identityFile); err != nil {
+		return nil, fmt.Errorf("failed to parse the identity file: %w", err)
+	}
+	if identityFile.ZtAPI == "" && len(identityFile.ZtAPIs) == 0 {
+		return nil, errors.New("the identity file has no ztAPI")
+	}
+	if identityFile.ID.Cert == "" || identityFile.ID.Key == "" {
+		return nil, errors.New("the identity file has no id.cert or id.key")
+	}
+	return &identityFile, nil
+}
+
+// ManagementEndpoints returns the Edge Management API URLs of the controllers the identity file points to.
+// A ztAPI points to the Edge Client API, which is served next to the Edge Management API, under the same path prefix
+// when the controller is behind a reverse proxy.
+func (f *ZitiIdentityFile) ManagementEndpoints() ([]string, error) {
+	ztAPIs := f.ZtAPIs
+	if len(ztAPIs) == 0 {
+		ztAPIs = []string{f.ZtAPI}
+	}
+
+	var endpoints []string
+	for _, ztAPI := range ztAPIs {
+		parsedUrl, err := url.Parse(ztAPI)
+		if err != nil || parsedUrl.Host == "" {
+			return nil, fmt.Errorf("invalid ztAPI %q of the identity file", ztAPI)
+		}
+		prefix := strings.TrimSuffix(parsedUrl.Path, "/")
+		prefix = strings.TrimSuffix(prefix, "/edge/client/v1")
+		endpoints = append(endpoints, fmt.Sprintf("%s://%s%s/edge/management/v1", parsedUrl.Scheme, parsedUrl.Host, prefix))
+	}
+	return endpoints, nil
+}
+
+// CertPem returns the PEM encoded client certificate of the identity.
+func (f *ZitiIdentityFile) CertPem() (string, error) {
+	return loadIdentityMaterial(f.ID.Cert)
+}
+
+// KeyPem returns the PEM encoded private key of the identity.
+func (f *ZitiIdentityFile) KeyPem() (string, error) {
+	return loadIdentityMaterial(f.ID.Key)
+}
+
+// CaPem returns the PEM encoded CA bundle of the controller, or an empty string when the identity file has none.
+func (f *ZitiIdentityFile) CaPem() (string, error) {
+	if f.ID.CA == "" {
+		return "", nil
+	}
+	return loadIdentityMaterial(f.ID.CA)
+}
+
+// loadIdentityMaterial resolves a value of an identity file, which is either inline PEM(prefixed with "pem:") or a path to a file.
+func loadIdentityMaterial(value string) (string, error) {
+	if pemValue, ok := strings.CutPrefix(value, "pem:"); ok {
+		return pemValue, nil
+	}
+	if strings.HasPrefix(value, "-----BEGIN") {
+		return value, nil
+	}
+
+	filePath := strings.TrimPrefix(value, "file://")
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s referenced by the identity file: %w", filePath, err)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPem = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func TestLoadIdentityMaterial(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(filePath, []byte(testPem), 0o600))
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "pem prefix", value: "pem:" + testPem},
+		{name: "inline pem", value: testPem},
+		{name: "file url", value: "file://" + filePath},
+		{name: "plain path", value: filePath},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			material, err := loadIdentityMaterial(test.value)
+			require.NoError(t, err)
+			assert.Equal(t, testPem, material)
+		})
+	}
+
+	_, err := loadIdentityMaterial(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.ErrorContains(t, err, "referenced by the identity file")
+}
+
+func TestManagementEndpoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		ztAPI     string
+		ztAPIs    []string
+		endpoints []string
+	}{
+		{
+			name:      "ztAPI",
+			ztAPI:     "https://ctrl.example.com:1280/edge/client/v1",
+			endpoints: []string{"https://ctrl.example.com:1280/edge/management/v1"},
+		},
+		{
+			name:      "ztAPI without a path",
+			ztAPI:     "https://ctrl.example.com:1280/",
+			endpoints: []string{"https://ctrl.example.com:1280/edge/management/v1"},
+		},
+		{
+			name:      "ztAPI with a path prefix",
+			ztAPI:     "https://proxy.example.com/ziti/edge/client/v1",
+			endpoints: []string{"https://proxy.example.com/ziti/edge/management/v1"},
+		},
+		{
+			name:   "ztAPIs take precedence over ztAPI",
+			ztAPI:  "https://ctrl.example.com/edge/client/v1",
+			ztAPIs: []string{"https://ctrl1.example.com/edge/client/v1", "https://ctrl2.example.com/prefix"},
+			endpoints: []string{
+				"https://ctrl1.example.com/edge/management/v1",
+				"https://ctrl2.example.com/prefix/edge/management/v1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identityFile := &ZitiIdentityFile{ZtAPI: test.ztAPI, ZtAPIs: test.ztAPIs}
+			endpoints, err := identityFile.ManagementEndpoints()
+			require.NoError(t, err)
+			assert.Equal(t, test.endpoints, endpoints)
+		})
+	}
+
+	_, err := (&ZitiIdentityFile{ZtAPI: "ctrl.example.com"}).ManagementEndpoints()
+	assert.Error(t, err)
+}
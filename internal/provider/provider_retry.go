@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// Defaults of the retry settings of the provider.
+const (
+	defaultMaxRetries      = 3
+	defaultRetryMinBackoff = "1s"
+	defaultRetryMaxBackoff = "30s"
+)
+
+// uniquelyNamedCreates are the create operations of entities whose names are unique, so that an earlier attempt
+// to create one can be found by its name before trying again.
+var uniquelyNamedCreates = map[string]bool{
+	"createAuthPolicy":              true,
+	"createCa":                      true,
+	"createConfig":                  true,
+	"createConfigType":              true,
+	"createEdgeRouter":              true,
+	"createEdgeRouterPolicy":        true,
+	"createExternalJwtSigner":       true,
+	"createIdentity":                true,
+	"createPostureCheck":            true,
+	"createService":                 true,
+	"createServiceEdgeRouterPolicy": true,
+	"createServicePolicy":           true,
+	"createTransitRouter":           true,
+}
+
+// retryPolicy tells how many times and how long after a failed request is sent again.
+type retryPolicy struct {
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// backoff returns how long to wait before the given retry, doubling from minBackoff up to maxBackoff, with jitter
+// so that clients failing at once do not retry at once.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	backoff := p.maxBackoff
+	if retry < 32 && p.minBackoff<<retry < p.maxBackoff {
+		backoff = p.minBackoff << retry
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// isIdempotent reports whether sending the request several times has the same effect as sending it once.
+func isIdempotent(operation *runtime.ClientOperation) bool {
+	switch operation.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isTransientError reports whether the request failed because the controller was temporarily unavailable, eg
+// restarting or electing a raft leader, in which case the request might have been processed or not.
+func isTransientError(err error) bool {
+	if code := statusCode(err); code == http.StatusBadGateway || code == http.StatusServiceUnavailable {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// createdName returns the name of the entity the operation creates, when it can be found by it. Otherwise, it returns
+// an empty string.
+func createdName(operation *runtime.ClientOperation) string {
+	if operation.Method != http.MethodPost || !uniquelyNamedCreates[operation.ID] {
+		return ""
+	}
+
+	// The body of the request is the field of the params which has a name, eg Identity of CreateIdentityParams.
+	params := reflect.ValueOf(operation.Params)
+	if params.Kind() == reflect.Ptr {
+		params = params.Elem()
+	}
+	if params.Kind() != reflect.Struct {
+		return ""
+	}
+	for i := 0; i < params.NumField(); i++ {
+		field := params.Field(i)
+		if !params.Type().Field(i).IsExported() || (field.Kind() != reflect.Ptr && field.Kind() != reflect.Interface) || field.IsNil() {
+			continue
+		}
+		body, err := json.Marshal(field.Interface())
+		if err != nil {
+			continue
+		}
+		var named struct {
+			Name *string `json:"name"`
+		}
+		if json.Unmarshal(body, &named) == nil && named.Name != nil && *named.Name != "" {
+			return *named.Name
+		}
+	}
+	return ""
+}
+
+// findCreated looks up the entity a create operation is for by its name. If there is one, it returns the response
+// the operation would have got, along with when the entity was created.
+func (t *managementTransport) findCreated(operation *runtime.ClientOperation, name string) (interface{}, time.Time, error) {
+	filter := "name = " + ZitiQlString(name)
+	result, err := t.submit(&runtime.ClientOperation{
+		ID:                 "findCreated",
+		Method:             http.MethodGet,
+		PathPattern:        operation.PathPattern,
+		ProducesMediaTypes: operation.ProducesMediaTypes,
+		ConsumesMediaTypes: operation.ConsumesMediaTypes,
+		Schemes:            operation.Schemes,
+		Context:            operation.Context,
+		Client:             operation.Client,
+		Params: runtime.ClientRequestWriterFunc(func(request runtime.ClientRequest, _ strfmt.Registry) error {
+			return request.SetQueryParam("filter", filter)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code() != http.StatusOK {
+				return nil, runtime.NewAPIError("findCreated", response.Message(), response.Code())
+			}
+			var list struct {
+				Data []createdEntity `json:"data"`
+			}
+			if err := consumer.Consume(response.Body(), &list); err != nil {
+				return nil, err
+			}
+			return list.Data, nil
+		}),
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to check whether %s has been created: %w", name, err)
+	}
+	entities, _ := result.([]createdEntity)
+	if len(entities) == 0 {
+		return nil, time.Time{}, nil
+	}
+
+	body, err := json.Marshal(map[string]any{"data": map[string]any{"id": entities[0].ID}, "meta": map[string]any{}})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	created, err := operation.Reader.ReadResponse(&createdResponse{body: body}, runtime.JSONConsumer())
+	return created, time.Time(entities[0].CreatedAt), err
+}
+
+// createdEntity is an entity of a list the controller responds with.
+type createdEntity struct {
+	ID        string          `json:"id"`
+	CreatedAt strfmt.DateTime `json:"createdAt"`
+}
+
+// createdResponse is a response to a create operation, made up for an entity which has been created by an earlier attempt.
+type createdResponse struct {
+	body []byte
+}
+
+func (r *createdResponse) Code() int {
+	return http.StatusCreated
+}
+
+func (r *createdResponse) Message() string {
+	return http.StatusText(http.StatusCreated)
+}
+
+func (r *createdResponse) GetHeader(string) string {
+	return ""
+}
+
+func (r *createdResponse) GetHeaders(string) []string {
+	return nil
+}
+
+func (r *createdResponse) Body() io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(r.body))
+}
+
+// sleep waits for the duration, unless the context is done first.
+func sleep(ctx context.Context, duration time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/edge-api/rest_management_api_client"
+	"github.com/openziti/edge-api/rest_management_api_client/auth_policy"
+	"github.com/openziti/edge-api/rest_management_api_client/certificate_authority"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_management_api_client/edge_router"
+	"github.com/openziti/edge-api/rest_management_api_client/edge_router_policy"
+	"github.com/openziti/edge-api/rest_management_api_client/external_jwt_signer"
+	"github.com/openziti/edge-api/rest_management_api_client/identity"
+	"github.com/openziti/edge-api/rest_management_api_client/posture_checks"
+	"github.com/openziti/edge-api/rest_management_api_client/router"
+	"github.com/openziti/edge-api/rest_management_api_client/service"
+	"github.com/openziti/edge-api/rest_management_api_client/service_edge_router_policy"
+	"github.com/openziti/edge-api/rest_management_api_client/service_policy"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTransport records the operations of the generated client instead of sending them.
+type recordingTransport struct {
+	operations []*runtime.ClientOperation
+}
+
+func (t *recordingTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	t.operations = append(t.operations, operation)
+	return nil, errors.New("not sent")
+}
+
+func TestUniquelyNamedCreatesAreOperations(t *testing.T) {
+	transport := &recordingTransport{}
+	client := rest_management_api_client.New(transport, strfmt.Default)
+
+	_, _ = client.AuthPolicy.CreateAuthPolicy(auth_policy.NewCreateAuthPolicyParams(), nil)
+	_, _ = client.CertificateAuthority.CreateCa(certificate_authority.NewCreateCaParams(), nil)
+	_, _ = client.Config.CreateConfig(config.NewCreateConfigParams(), nil)
+	_, _ = client.Config.CreateConfigType(config.NewCreateConfigTypeParams(), nil)
+	_, _ = client.EdgeRouter.CreateEdgeRouter(edge_router.NewCreateEdgeRouterParams(), nil)
+	_, _ = client.EdgeRouterPolicy.CreateEdgeRouterPolicy(edge_router_policy.NewCreateEdgeRouterPolicyParams(), nil)
+	_, _ = client.ExternalJWTSigner.CreateExternalJWTSigner(external_jwt_signer.NewCreateExternalJWTSignerParams(), nil)
+	_, _ = client.Identity.CreateIdentity(identity.NewCreateIdentityParams(), nil)
+	_, _ = client.PostureChecks.CreatePostureCheck(posture_checks.NewCreatePostureCheckParams(), nil)
+	_, _ = client.Service.CreateService(service.NewCreateServiceParams(), nil)
+	_, _ = client.ServiceEdgeRouterPolicy.CreateServiceEdgeRouterPolicy(service_edge_router_policy.NewCreateServiceEdgeRouterPolicyParams(), nil)
+	_, _ = client.ServicePolicy.CreateServicePolicy(service_policy.NewCreateServicePolicyParams(), nil)
+	_, _ = client.Router.CreateTransitRouter(router.NewCreateTransitRouterParams(), nil)
+
+	var operationIds []string
+	for _, operation := range transport.operations {
+		assert.Equal(t, http.MethodPost, operation.Method, operation.ID)
+		operationIds = append(operationIds, operation.ID)
+	}
+	var uniquelyNamed []string
+	for operationId := range uniquelyNamedCreates {
+		uniquelyNamed = append(uniquelyNamed, operationId)
+	}
+	assert.ElementsMatch(t, operationIds, uniquelyNamed)
+}
+
+func TestCreatedName(t *testing.T) {
+	transport := &recordingTransport{}
+	client := rest_management_api_client.New(transport, strfmt.Default)
+
+	name := "identity"
+	_, _ = client.Identity.CreateIdentity(identity.NewCreateIdentityParams().WithIdentity(&rest_model.IdentityCreate{Name: &name}), nil)
+	_, _ = client.Identity.CreateIdentity(identity.NewCreateIdentityParams(), nil)
+	_, _ = client.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+	require.Len(t, transport.operations, 3)
+
+	assert.Equal(t, "identity", createdName(transport.operations[0]))
+	assert.Equal(t, "", createdName(transport.operations[1]))
+	assert.Equal(t, "", createdName(transport.operations[2]))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{maxRetries: 3, minBackoff: time.Second, maxBackoff: 30 * time.Second}
+	for retry, expected := range map[int]time.Duration{
+		0:   time.Second,
+		1:   2 * time.Second,
+		4:   16 * time.Second,
+		5:   30 * time.Second,
+		40:  30 * time.Second,
+		100: 30 * time.Second,
+	} {
+		for i := 0; i < 100; i++ {
+			backoff := policy.backoff(retry)
+			assert.GreaterOrEqual(t, backoff, expected/2, "retry %d", retry)
+			assert.LessOrEqual(t, backoff, expected, "retry %d", retry)
+		}
+	}
+
+	assert.Equal(t, time.Duration(0), retryPolicy{}.backoff(0))
+}
+
+// newRetryingManagementClient returns a client of the controller which retries at once.
+func newRetryingManagementClient(t *testing.T, controller *fakeController) *rest_management_api_client.ZitiEdgeManagement {
+	client, transport := newTestManagementClient(t, controller, controller.ManagementUrl(t))
+	transport.retry = retryPolicy{maxRetries: 2, minBackoff: time.Millisecond, maxBackoff: time.Millisecond}
+	return client.API.ZitiEdgeManagement
+}
+
+func TestManagementTransportRetriesTransientErrors(t *testing.T) {
+	controller := newFakeController(t)
+	controller.HandleAuthentication()
+	var requests atomic.Int32
+	controller.Mux.HandleFunc("GET /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			writeApiError(w, http.StatusServiceUnavailable, "UNAVAILABLE")
+		case 2:
+			writeApiError(w, http.StatusBadGateway, "BAD_GATEWAY")
+		default:
+			writeJson(w, http.StatusOK, map[string]any{
+				"data": map[string]any{"id": "identity-id", "name": "identity"},
+				"meta": map[string]any{},
+			})
+		}
+	})
+	var patches atomic.Int32
+	controller.Mux.HandleFunc("PATCH /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+		patches.Add(1)
+		writeApiError(w, http.StatusServiceUnavailable, "UNAVAILABLE")
+	})
+	client := newRetryingManagementClient(t, controller)
+
+	detail, err := client.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "identity", *detail.Payload.Data.Name)
+	assert.EqualValues(t, 3, requests.Load())
+
+	// A patch might have been applied, and is not idempotent, eg when it adds to a list.
+	_, err = client.Identity.PatchIdentity(identity.NewPatchIdentityParams().WithID("identity-id").WithIdentity(&rest_model.IdentityPatch{}), nil)
+	assert.IsType(t, &identity.PatchIdentityServiceUnavailable{}, err)
+	assert.EqualValues(t, 1, patches.Load())
+}
+
+func TestManagementTransportFindsCreated(t *testing.T) {
+	tests := []struct {
+		name      string
+		clock     time.Duration
+		createdAt time.Time
+		adopted   bool
+	}{
+		{name: "created by an earlier attempt", createdAt: time.Now().Add(time.Minute), adopted: true},
+		{name: "created before the first attempt", createdAt: time.Now().Add(-time.Minute), adopted: false},
+		{name: "created by an earlier attempt by a controller behind", clock: -time.Hour, createdAt: time.Now().Add(-time.Hour + time.Minute), adopted: true},
+		{name: "created before the first attempt by a controller ahead", clock: time.Hour, createdAt: time.Now().Add(time.Hour - time.Minute), adopted: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := newFakeController(t)
+			controller.HandleAuthentication()
+			var creates atomic.Int32
+			controller.Mux.HandleFunc("POST /edge/management/v1/identities", func(w http.ResponseWriter, r *http.Request) {
+				creates.Add(1)
+				w.Header().Set("Date", time.Now().Add(test.clock).UTC().Format(http.TimeFormat))
+				writeApiError(w, http.StatusServiceUnavailable, "UNAVAILABLE")
+			})
+			filters := make(chan string, 1)
+			controller.Mux.HandleFunc("GET /edge/management/v1/identities", func(w http.ResponseWriter, r *http.Request) {
+				filters <- r.URL.Query().Get("filter")
+				w.Header().Set("Date", time.Now().Add(test.clock).UTC().Format(http.TimeFormat))
+				writeJson(w, http.StatusOK, map[string]any{
+					"data": []any{map[string]any{"id": "identity-id", "createdAt": strfmt.DateTime(test.createdAt).String()}},
+					"meta": map[string]any{},
+				})
+			})
+			client := newRetryingManagementClient(t, controller)
+
+			name := `web "1"`
+			created, err := client.Identity.CreateIdentity(identity.NewCreateIdentityParams().WithIdentity(&rest_model.IdentityCreate{Name: &name}), nil)
+			require.Len(t, filters, 1)
+			assert.Equal(t, `name = "web \"1\""`, <-filters)
+			assert.EqualValues(t, 1, creates.Load())
+			if test.adopted {
+				require.NoError(t, err)
+				assert.Equal(t, "identity-id", created.Payload.Data.ID)
+			} else {
+				assert.IsType(t, &identity.CreateIdentityServiceUnavailable{}, err)
+			}
+		})
+	}
+}
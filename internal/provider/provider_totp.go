@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Parameters of the TOTP codes the Ziti controller expects, which are the defaults of RFC 6238.
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+)
+
+// decodeTotpSecret decodes a base32 encoded TOTP secret, regardless of its case, spaces and padding.
+func decodeTotpSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(normalized, "="))
+	if err != nil {
+		return nil, fmt.Errorf("the TOTP secret is not base32 encoded: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("the TOTP secret is empty")
+	}
+	return key, nil
+}
+
+// totpCodeAt generates the RFC 6238 code of a TOTP key for the given time.
+func totpCodeAt(key []byte, at time.Time) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(at.Unix()/int64(totpPeriod.Seconds())))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, see RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+// newTotpCallback returns the callback the client asks for a TOTP code with, when the auth policy of the identity requires one.
+// A code is generated from the secret on every call, so that authenticating again later gets a fresh one. The secret is
+// decoded upfront, so that an invalid one fails the configuration of the provider rather than sending an empty code.
+func newTotpCallback(totpSecret string, totpCode string) (func(chan string), error) {
+	if totpSecret == "" && totpCode == "" {
+		return emptyTotpCallback, nil
+	}
+	if totpSecret == "" {
+		return func(ch chan string) {
+			ch <- totpCode
+			close(ch)
+		}, nil
+	}
+
+	key, err := decodeTotpSecret(totpSecret)
+	if err != nil {
+		return nil, err
+	}
+	return func(ch chan string) {
+		ch <- totpCodeAt(key, time.Now())
+		close(ch)
+	}, nil
+}
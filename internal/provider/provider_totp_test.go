@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SHA1 test vectors of RFC 6238 Appendix B, truncated to 6 digits. The secret is the base32 encoding of the
+// ASCII key "12345678901234567890".
+func TestTotpCodeAt(t *testing.T) {
+	key, err := decodeTotpSecret("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	require.NoError(t, err)
+	require.Equal(t, []byte("12345678901234567890"), key)
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.code, totpCodeAt(key, time.Unix(test.unix, 0)), "at %d", test.unix)
+	}
+}
+
+func TestDecodeTotpSecret(t *testing.T) {
+	for _, secret := range []string{"gezd gnbv gy3t qojq gezd gnbv gy3t qojq", "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ===="} {
+		key, err := decodeTotpSecret(secret)
+		require.NoError(t, err, secret)
+		assert.Equal(t, []byte("12345678901234567890"), key)
+	}
+
+	for _, secret := range []string{"", "  ", "GEZDGNBV1"} {
+		_, err := decodeTotpSecret(secret)
+		assert.Error(t, err, secret)
+	}
+}
+
+func TestNewTotpCallback(t *testing.T) {
+	_, err := newTotpCallback("not base32!", "")
+	assert.ErrorContains(t, err, "not base32 encoded")
+
+	callback, err := newTotpCallback("", "123456")
+	require.NoError(t, err)
+	ch := make(chan string)
+	go callback(ch)
+	assert.Equal(t, "123456", <-ch)
+
+	callback, err = newTotpCallback("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", "")
+	require.NoError(t, err)
+	ch = make(chan string)
+	go callback(ch)
+	assert.Regexp(t, `^\d{6}$`, <-ch)
+}
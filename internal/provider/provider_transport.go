@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// managementTransport replaces the transport of the Edge Management API client shared by all the resources. It sends
+// operations to the controllers of a cluster, failing over to the next one when a controller cannot be reached, and
+// keeps their API sessions usable during long applies.
+type managementTransport struct {
+	ctx         context.Context
+	endpoints   []*managementEndpoint
+	credentials edge_apis.Credentials
+	configTypes []string
+
+	// refreshCredentials reloads credentials which might have been rotated, and returns new ones if they have changed.
+	refreshCredentials func() (edge_apis.Credentials, error)
+	// retry tells how to retry requests which failed while the controllers were unavailable.
+	retry retryPolicy
+
+	// mutex guards active and credentials.
+	mutex  sync.Mutex
+	active int
+}
+
+// managementEndpoint is a controller of the cluster. It has a client of its own, so that it keeps its own API session.
+type managementEndpoint struct {
+	url    *url.URL
+	client *edge_apis.ManagementApiClient
+
+	mutex         sync.Mutex
+	authenticated bool
+	// session counts the API sessions created, to tell whether an expired one has been replaced already.
+	session int
+}
+
+// installManagementTransport replaces the transport of the client with a managementTransport over apiUrls, which are
+// tried in order, or in a random order when shuffle is set.
+func installManagementTransport(ctx context.Context, client *edge_apis.ManagementApiClient, apiUrls []*url.URL, shuffle bool, credentials edge_apis.Credentials, configTypes []string, totpCallback func(chan string)) *managementTransport {
+	transport := &managementTransport{
+		ctx:         ctx,
+		credentials: credentials,
+		configTypes: configTypes,
+	}
+	for _, apiUrl := range apiUrls {
+		transport.endpoints = append(transport.endpoints, &managementEndpoint{
+			url:    apiUrl,
+			client: edge_apis.NewManagementApiClient([]*url.URL{apiUrl}, credentials.GetCaPool(), totpCallback),
+		})
+	}
+	if shuffle {
+		rand.Shuffle(len(transport.endpoints), func(i, j int) {
+			transport.endpoints[i], transport.endpoints[j] = transport.endpoints[j], transport.endpoints[i]
+		})
+	}
+	client.API.SetTransport(transport)
+	return transport
+}
+
+// connect authenticates to the first controller that can be reached.
+func (t *managementTransport) connect() error {
+	_, err := t.failover("authenticate", func(endpoint *managementEndpoint) (interface{}, error) {
+		_, err := endpoint.authenticate(t.sessionCredentials, t.configTypes)
+		return nil, err
+	})
+	return err
+}
+
+func (t *managementTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	result, err := t.submitWithRetries(operation)
+	// Resources tell the errors of the generated client apart by their type, eg to find out an entity is gone.
+	if responseErr, ok := err.(*responseError); ok {
+		return result, responseErr.error
+	}
+	return result, err
+}
+
+// submitWithRetries sends the operation, retrying while the controllers are unavailable.
+func (t *managementTransport) submitWithRetries(operation *runtime.ClientOperation) (interface{}, error) {
+	firstAttempt := time.Now()
+	// clockOffset tells the time of the first attempt by the clock of the controllers, which created entities are
+	// timestamped with.
+	var clockOffset time.Duration
+	clockKnown := false
+	for retry := 0; ; retry++ {
+		result, err := t.submit(operation)
+		if !clockKnown {
+			clockOffset, clockKnown = controllerClockOffset(err)
+		}
+		if err == nil || retry >= t.retry.maxRetries {
+			return result, err
+		}
+
+		// A request which has not reached any controller can always be sent again. Otherwise, it might have been
+		// processed already, so only idempotent ones and creates which can be checked for are.
+		name := createdName(operation)
+		if !isConnectionError(err) && (!isTransientError(err) || (!isIdempotent(operation) && name == "")) {
+			return result, err
+		}
+
+		backoff := t.retry.backoff(retry)
+		tflog.Warn(t.ctx, "Request to the Ziti Edge Management API failed, retrying", map[string]any{
+			"operation": operation.ID,
+			"retry":     retry + 1,
+			"backoff":   backoff.String(),
+			"error":     err.Error(),
+		})
+		if err := sleep(operation.Context, backoff); err != nil {
+			return nil, err
+		}
+
+		if name != "" && !isConnectionError(err) {
+			created, createdAt, findErr := t.findCreated(operation, name)
+			if findErr != nil {
+				return nil, findErr
+			}
+			if created != nil {
+				// An entity created before the first attempt is not the one the operation is for, so it is not adopted.
+				if createdAt.Before(firstAttempt.Add(clockOffset)) {
+					return result, err
+				}
+				tflog.Info(t.ctx, "An earlier attempt of "+operation.ID+" has created "+name+" already")
+				return created, nil
+			}
+		}
+	}
+}
+
+// submit sends the operation to the controllers, authenticating again once when the API session is no longer valid.
+func (t *managementTransport) submit(operation *runtime.ClientOperation) (interface{}, error) {
+	operation = readStatusCodes(operation)
+	return t.failover(operation.ID, func(endpoint *managementEndpoint) (interface{}, error) {
+		session, err := endpoint.authenticate(t.sessionCredentials, t.configTypes)
+		if err != nil {
+			return nil, err
+		}
+		result, err := endpoint.client.API.Transport.Submit(operation)
+		if err == nil || !isUnauthorized(err) {
+			return result, err
+		}
+
+		// The API session has expired or has been removed, so create a new one and send the request once more.
+		tflog.Info(t.ctx, "API session of the Ziti controller is no longer valid, authenticating again", map[string]any{
+			"ziti_controller": endpoint.url.String(),
+			"operation":       operation.ID,
+		})
+		if _, err := endpoint.reauthenticate(t.sessionCredentials, t.configTypes, session); err != nil {
+			return nil, err
+		}
+		return endpoint.client.API.Transport.Submit(operation)
+	})
+}
+
+// failover calls submit with the active controller, and with the next ones as long as the controller cannot be reached.
+func (t *managementTransport) failover(operationId string, submit func(endpoint *managementEndpoint) (interface{}, error)) (interface{}, error) {
+	t.mutex.Lock()
+	active := t.active
+	t.mutex.Unlock()
+
+	var err error
+	for i := range t.endpoints {
+		index := (active + i) % len(t.endpoints)
+		endpoint := t.endpoints[index]
+
+		var result interface{}
+		result, err = submit(endpoint)
+		if err != nil && isConnectionError(err) {
+			tflog.Warn(t.ctx, "Unable to reach the Ziti controller, failing over to the next one", map[string]any{
+				"ziti_controller": endpoint.url.String(),
+				"operation":       operationId,
+				"error":           err.Error(),
+			})
+			continue
+		}
+
+		t.mutex.Lock()
+		t.active = index
+		t.mutex.Unlock()
+
+		tflog.Debug(t.ctx, "Ziti controller served "+operationId, map[string]any{"ziti_controller": endpoint.url.String()})
+		return result, err
+	}
+	return nil, fmt.Errorf("none of the Ziti controllers can be reached: %w", err)
+}
+
+// sessionCredentials returns the credentials to create an API session with, reloading them first when they might
+// have been rotated. Credentials are replaced rather than modified, since API sessions are created concurrently.
+func (t *managementTransport) sessionCredentials() (edge_apis.Credentials, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.refreshCredentials != nil {
+		credentials, err := t.refreshCredentials()
+		if err != nil {
+			return nil, err
+		}
+		if credentials != nil {
+			tflog.Info(t.ctx, "Credentials of the Ziti Edge Management API have changed")
+			t.credentials = credentials
+		}
+	}
+	return t.credentials, nil
+}
+
+// authenticate creates an API session with the controller, unless it already has one, and returns its number.
+func (e *managementEndpoint) authenticate(credentials func() (edge_apis.Credentials, error), configTypes []string) (int, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.authenticated {
+		return e.session, nil
+	}
+	return e.createSession(credentials, configTypes)
+}
+
+// reauthenticate replaces the given API session with a new one, unless a concurrent request has replaced it already.
+func (e *managementEndpoint) reauthenticate(credentials func() (edge_apis.Credentials, error), configTypes []string, session int) (int, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.authenticated && e.session != session {
+		return e.session, nil
+	}
+	return e.createSession(credentials, configTypes)
+}
+
+func (e *managementEndpoint) createSession(credentials func() (edge_apis.Credentials, error), configTypes []string) (int, error) {
+	e.authenticated = false
+	current, err := credentials()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := e.client.Authenticate(current, configTypes); err != nil {
+		return 0, fmt.Errorf("failed to authenticate to the Ziti controller %s: %w", e.url, err)
+	}
+	e.authenticated = true
+	e.session++
+	return e.session, nil
+}
+
+// responseError is an error read from a response of the controller, along with its status code. The generated client
+// has an error type per operation and status code, which have no method to tell the status code by.
+type responseError struct {
+	error
+	code int
+
+	// date is the Date header of the response, if any, and received is when the response was read.
+	date     time.Time
+	received time.Time
+}
+
+func (e *responseError) Unwrap() error {
+	return e.error
+}
+
+// readStatusCodes returns a copy of the operation whose errors read from a response are responseErrors.
+func readStatusCodes(operation *runtime.ClientOperation) *runtime.ClientOperation {
+	reader := operation.Reader
+	withStatusCodes := *operation
+	withStatusCodes.Reader = runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+		result, err := reader.ReadResponse(response, consumer)
+		if err != nil {
+			responseErr := &responseError{error: err, code: response.Code(), received: time.Now()}
+			if date, dateErr := http.ParseTime(response.GetHeader("Date")); dateErr == nil {
+				responseErr.date = date
+			}
+			return result, responseErr
+		}
+		return result, nil
+	})
+	return &withStatusCodes
+}
+
+// statusCode returns the status code of the response the request failed with, or 0 when it failed without one.
+func statusCode(err error) int {
+	var responseErr *responseError
+	if errors.As(err, &responseErr) {
+		return responseErr.code
+	}
+	return 0
+}
+
+// controllerClockOffset returns how far the clock of the controller which responded with err is ahead of the local
+// one, as told by the Date header of the response. As the header is truncated to the second and sent before the
+// response is read, the offset is never later than the actual one.
+func controllerClockOffset(err error) (time.Duration, bool) {
+	var responseErr *responseError
+	if !errors.As(err, &responseErr) || responseErr.date.IsZero() {
+		return 0, false
+	}
+	return responseErr.date.Sub(responseErr.received), true
+}
+
+// isUnauthorized reports whether the controller rejected the request because of a missing, expired or invalid API session.
+func isUnauthorized(err error) bool {
+	return statusCode(err) == http.StatusUnauthorized
+}
+
+// isConnectionError reports whether the error means the controller could not be reached, so the request was not
+// processed and can be sent to another controller.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// rotatingJwtFile reads the JWT of JWT credentials from a file, which is rewritten whenever the token rotates. It is
+// not safe for concurrent use, the transport refreshes it under its mutex.
+type rotatingJwtFile struct {
+	path   string
+	caPool *x509.CertPool
+
+	modTime time.Time
+	token   string
+}
+
+// newRotatingJwtFile reads the JWT from path, for credentials which trust caPool.
+func newRotatingJwtFile(path string, caPool *x509.CertPool) (*rotatingJwtFile, error) {
+	file := &rotatingJwtFile{
+		path:   path,
+		caPool: caPool,
+	}
+	if _, err := file.refresh(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// credentials returns credentials of the JWT read last.
+func (f *rotatingJwtFile) credentials() *edge_apis.JwtCredentials {
+	credentials := edge_apis.NewJwtCredentials(f.token)
+	credentials.CaPool = f.caPool
+	return credentials
+}
+
+// refresh reads the JWT again if the file has been modified, and returns new credentials if the token has changed.
+func (f *rotatingJwtFile) refresh() (edge_apis.Credentials, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the JWT file: %w", err)
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the JWT file: %w", err)
+	}
+	f.modTime = info.ModTime()
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return nil, fmt.Errorf("the JWT file %s is empty", f.path)
+	}
+	if token == f.token {
+		return nil, nil
+	}
+	f.token = token
+	return f.credentials(), nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openziti/edge-api/rest_management_api_client/identity"
+	"github.com/openziti/sdk-golang/edge-apis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestManagementClient returns a client of the Edge Management API whose requests are sent through a
+// managementTransport over the controllers at apiUrls, which has authenticated with a password.
+func newTestManagementClient(t *testing.T, controller *fakeController, apiUrls ...*url.URL) (*edge_apis.ManagementApiClient, *managementTransport) {
+	t.Helper()
+
+	credentials := edge_apis.NewUpdbCredentials("admin", "admin")
+	credentials.CaPool = controller.CaPool()
+	client := edge_apis.NewManagementApiClient(apiUrls, credentials.CaPool, emptyTotpCallback)
+	transport := installManagementTransport(context.Background(), client, apiUrls, false, credentials, nil, emptyTotpCallback)
+	require.NoError(t, transport.connect())
+	return client, transport
+}
+
+// handleIdentity serves the detail of an identity to the given API session, and rejects the others as unauthorized.
+// It returns the number of requests.
+func handleIdentity(controller *fakeController, token string) *atomic.Int32 {
+	var requests atomic.Int32
+	controller.Mux.HandleFunc("GET /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("zt-session") != token {
+			writeApiError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]any{
+			"data": map[string]any{"id": "identity-id", "name": "identity"},
+			"meta": map[string]any{},
+		})
+	})
+	return &requests
+}
+
+func TestManagementTransportAuthenticatesAgain(t *testing.T) {
+	controller := newFakeController(t)
+	sessions := controller.HandleAuthentication()
+	requests := handleIdentity(controller, "token-2")
+	client, _ := newTestManagementClient(t, controller, controller.ManagementUrl(t))
+
+	detail, err := client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "identity", *detail.Payload.Data.Name)
+	assert.EqualValues(t, 2, sessions.Load())
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestManagementTransportKeepsErrorTypes(t *testing.T) {
+	controller := newFakeController(t)
+	sessions := controller.HandleAuthentication()
+	requests := handleIdentity(controller, "never-valid")
+	controller.Mux.HandleFunc("GET /edge/management/v1/identities/missing-id", func(w http.ResponseWriter, r *http.Request) {
+		writeApiError(w, http.StatusNotFound, "NOT_FOUND")
+	})
+	client, _ := newTestManagementClient(t, controller, controller.ManagementUrl(t))
+
+	_, err := client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("missing-id"), nil)
+	assert.IsType(t, &identity.DetailIdentityNotFound{}, err)
+
+	// An API session which is rejected right after it has been created is not replaced again.
+	_, err = client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+	assert.IsType(t, &identity.DetailIdentityUnauthorized{}, err)
+	assert.EqualValues(t, 2, sessions.Load())
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestManagementTransportFailsOver(t *testing.T) {
+	controller := newFakeController(t)
+	sessions := controller.HandleAuthentication()
+	requests := handleIdentity(controller, "token-1")
+
+	// A controller which refuses connections, since nothing listens on its port anymore.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+	unreachableUrl, err := url.Parse("https://" + listener.Addr().String() + "/edge/management/v1")
+	require.NoError(t, err)
+
+	client, transport := newTestManagementClient(t, controller, unreachableUrl, controller.ManagementUrl(t))
+	assert.Equal(t, 1, transport.active)
+
+	for i := 0; i < 2; i++ {
+		detail, err := client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "identity", *detail.Payload.Data.Name)
+	}
+	assert.Equal(t, 1, transport.active)
+	assert.EqualValues(t, 1, sessions.Load())
+	assert.EqualValues(t, 2, requests.Load())
+
+	controller.Close()
+	for _, endpoint := range transport.endpoints {
+		endpoint.client.HttpTransport.CloseIdleConnections()
+	}
+	_, err = client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+	assert.ErrorContains(t, err, "none of the Ziti controllers can be reached")
+}
+
+func TestManagementTransportAuthenticatesAgainOnceForConcurrentRequests(t *testing.T) {
+	const concurrency = 8
+
+	controller := newFakeController(t)
+	sessions := controller.HandleAuthentication()
+	// Every request with the first API session is rejected once all of them have been received, so that they all
+	// find out it has expired at once.
+	var expired atomic.Int32
+	release := make(chan struct{})
+	controller.Mux.HandleFunc("GET /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("zt-session") == "token-1" {
+			if expired.Add(1) == concurrency {
+				close(release)
+			}
+			select {
+			case <-release:
+			case <-time.After(5 * time.Second):
+			}
+			writeApiError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]any{
+			"data": map[string]any{"id": "identity-id", "name": "identity"},
+			"meta": map[string]any{},
+		})
+	})
+	client, _ := newTestManagementClient(t, controller, controller.ManagementUrl(t))
+
+	var wg sync.WaitGroup
+	errs := make([]error, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, concurrency, expired.Load())
+	assert.EqualValues(t, 2, sessions.Load())
+}
+
+func TestManagementTransportReadsRotatedJwtFile(t *testing.T) {
+	controller := newFakeController(t)
+	var mutex sync.Mutex
+	var bearers []string
+	authenticatedWith := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string{}, bearers...)
+	}
+	var sessions atomic.Int32
+	controller.Mux.HandleFunc("POST /edge/management/v1/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		bearers = append(bearers, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		mutex.Unlock()
+		session := strconv.Itoa(int(sessions.Add(1)))
+		writeJson(w, http.StatusOK, map[string]any{
+			"data": map[string]any{"id": "session-" + session, "token": "token-" + session},
+			"meta": map[string]any{},
+		})
+	})
+	controller.Mux.HandleFunc("GET /edge/management/v1/controllers", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, http.StatusOK, map[string]any{"data": []any{}, "meta": map[string]any{}})
+	})
+	var validSession atomic.Value
+	validSession.Store("token-1")
+	controller.Mux.HandleFunc("GET /edge/management/v1/identities/identity-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("zt-session") != validSession.Load() {
+			writeApiError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]any{
+			"data": map[string]any{"id": "identity-id", "name": "identity"},
+			"meta": map[string]any{},
+		})
+	})
+
+	jwtPath := filepath.Join(t.TempDir(), "token.jwt")
+	require.NoError(t, os.WriteFile(jwtPath, []byte("jwt-1\n"), 0o600))
+	jwtFile, err := newRotatingJwtFile(jwtPath, controller.CaPool())
+	require.NoError(t, err)
+
+	apiUrls := []*url.URL{controller.ManagementUrl(t)}
+	client := edge_apis.NewManagementApiClient(apiUrls, controller.CaPool(), emptyTotpCallback)
+	transport := installManagementTransport(context.Background(), client, apiUrls, false, jwtFile.credentials(), nil, emptyTotpCallback)
+	transport.refreshCredentials = jwtFile.refresh
+	require.NoError(t, transport.connect())
+
+	detail := func() {
+		t.Helper()
+		_, err := client.API.Identity.DetailIdentity(identity.NewDetailIdentityParams().WithID("identity-id"), nil)
+		require.NoError(t, err)
+	}
+	detail()
+
+	// The rotated token is used once the API session expires, rather than as soon as the file changes.
+	require.NoError(t, os.WriteFile(jwtPath, []byte("jwt-2\n"), 0o600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(jwtPath, modTime, modTime))
+	detail()
+	assert.Equal(t, []string{"jwt-1"}, authenticatedWith())
+
+	validSession.Store("token-2")
+	detail()
+	assert.Equal(t, []string{"jwt-1", "jwt-2"}, authenticatedWith())
+}
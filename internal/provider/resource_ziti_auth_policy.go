@@ -0,0 +1,612 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/auth_policy"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiAuthPolicyResource{}
+var _ resource.ResourceWithImportState = &ZitiAuthPolicyResource{}
+
+func NewZitiAuthPolicyResource() resource.Resource {
+	return &ZitiAuthPolicyResource{}
+}
+
+// ZitiAuthPolicyResource defines the resource implementation.
+type ZitiAuthPolicyResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+var AuthPolicyCertModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"allowed":             types.BoolType,
+		"allow_expired_certs": types.BoolType,
+	},
+}
+
+var AuthPolicyUpdbModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"allowed":                  types.BoolType,
+		"min_password_length":      types.Int64Type,
+		"require_mixed_case":       types.BoolType,
+		"require_number_char":      types.BoolType,
+		"require_special_char":     types.BoolType,
+		"max_attempts":             types.Int64Type,
+		"lockout_duration_minutes": types.Int64Type,
+	},
+}
+
+var AuthPolicyExtJWTModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"allowed":         types.BoolType,
+		"allowed_signers": types.ListType{ElemType: types.StringType},
+	},
+}
+
+var AuthPolicyPrimaryModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"cert":    AuthPolicyCertModel,
+		"updb":    AuthPolicyUpdbModel,
+		"ext_jwt": AuthPolicyExtJWTModel,
+	},
+}
+
+var AuthPolicySecondaryModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"require_totp":           types.BoolType,
+		"require_ext_jwt_signer": types.StringType,
+	},
+}
+
+// Defaults mirror the settings of the "default" auth policy every controller ships with.
+var defaultAuthPolicyCert = types.ObjectValueMust(AuthPolicyCertModel.AttrTypes, map[string]attr.Value{
+	"allowed":             types.BoolValue(true),
+	"allow_expired_certs": types.BoolValue(true),
+})
+
+var defaultAuthPolicyUpdb = types.ObjectValueMust(AuthPolicyUpdbModel.AttrTypes, map[string]attr.Value{
+	"allowed":                  types.BoolValue(true),
+	"min_password_length":      types.Int64Value(5),
+	"require_mixed_case":       types.BoolValue(false),
+	"require_number_char":      types.BoolValue(false),
+	"require_special_char":     types.BoolValue(false),
+	"max_attempts":             types.Int64Value(0),
+	"lockout_duration_minutes": types.Int64Value(0),
+})
+
+var defaultAuthPolicyExtJWT = types.ObjectValueMust(AuthPolicyExtJWTModel.AttrTypes, map[string]attr.Value{
+	"allowed":         types.BoolValue(true),
+	"allowed_signers": types.ListNull(types.StringType),
+})
+
+var defaultAuthPolicyPrimary = types.ObjectValueMust(AuthPolicyPrimaryModel.AttrTypes, map[string]attr.Value{
+	"cert":    defaultAuthPolicyCert,
+	"updb":    defaultAuthPolicyUpdb,
+	"ext_jwt": defaultAuthPolicyExtJWT,
+})
+
+var defaultAuthPolicySecondary = types.ObjectValueMust(AuthPolicySecondaryModel.AttrTypes, map[string]attr.Value{
+	"require_totp":           types.BoolValue(false),
+	"require_ext_jwt_signer": types.StringNull(),
+})
+
+type AuthPolicyCertObject struct {
+	Allowed           types.Bool `tfsdk:"allowed"`
+	AllowExpiredCerts types.Bool `tfsdk:"allow_expired_certs"`
+}
+
+type AuthPolicyUpdbObject struct {
+	Allowed                types.Bool  `tfsdk:"allowed"`
+	MinPasswordLength      types.Int64 `tfsdk:"min_password_length"`
+	RequireMixedCase       types.Bool  `tfsdk:"require_mixed_case"`
+	RequireNumberChar      types.Bool  `tfsdk:"require_number_char"`
+	RequireSpecialChar     types.Bool  `tfsdk:"require_special_char"`
+	MaxAttempts            types.Int64 `tfsdk:"max_attempts"`
+	LockoutDurationMinutes types.Int64 `tfsdk:"lockout_duration_minutes"`
+}
+
+type AuthPolicyExtJWTObject struct {
+	Allowed        types.Bool `tfsdk:"allowed"`
+	AllowedSigners types.List `tfsdk:"allowed_signers"`
+}
+
+type AuthPolicyPrimaryObject struct {
+	Cert   types.Object `tfsdk:"cert"`
+	Updb   types.Object `tfsdk:"updb"`
+	ExtJWT types.Object `tfsdk:"ext_jwt"`
+}
+
+type AuthPolicySecondaryObject struct {
+	RequireTotp         types.Bool   `tfsdk:"require_totp"`
+	RequireExtJWTSigner types.String `tfsdk:"require_ext_jwt_signer"`
+}
+
+// ZitiAuthPolicyResourceModel describes the resource data model.
+type ZitiAuthPolicyResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name      types.String `tfsdk:"name"`
+	Primary   types.Object `tfsdk:"primary"`
+	Secondary types.Object `tfsdk:"secondary"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func (r *ZitiAuthPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_policy"
+}
+
+func (r *ZitiAuthPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an auth policy of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the auth policy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the auth policy",
+				Required:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the auth policy",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+			"primary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Primary authentication methods allowed by the policy",
+				Optional:            true,
+				Computed:            true,
+				Default:             objectdefault.StaticValue(defaultAuthPolicyPrimary),
+				Attributes: map[string]schema.Attribute{
+					"cert": schema.SingleNestedAttribute{
+						MarkdownDescription: "Certificate based authentication settings",
+						Optional:            true,
+						Computed:            true,
+						Default:             objectdefault.StaticValue(defaultAuthPolicyCert),
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether certificate authentication is allowed(default true)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+							},
+							"allow_expired_certs": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether expired certificates are allowed to authenticate(default true)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+							},
+						},
+					},
+					"updb": schema.SingleNestedAttribute{
+						MarkdownDescription: "Username/password authentication settings",
+						Optional:            true,
+						Computed:            true,
+						Default:             objectdefault.StaticValue(defaultAuthPolicyUpdb),
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether username/password authentication is allowed(default true)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+							},
+							"min_password_length": schema.Int64Attribute{
+								MarkdownDescription: "Minimal length of a password(default 5)",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(5),
+								Validators: []validator.Int64{
+									int64validator.Between(5, 100),
+								},
+							},
+							"require_mixed_case": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether a password must contain both lower and upper case characters(default false)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"require_number_char": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether a password must contain a number(default false)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"require_special_char": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether a password must contain a special character(default false)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"max_attempts": schema.Int64Attribute{
+								MarkdownDescription: "Amount of failed attempts before an identity is locked out. Defaults to 0, which indicates no limit",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(0),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"lockout_duration_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Duration of a lockout in minutes. Defaults to 0, which indicates a lockout until an administrator intervenes",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(0),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+						},
+					},
+					"ext_jwt": schema.SingleNestedAttribute{
+						MarkdownDescription: "External JWT authentication settings",
+						Optional:            true,
+						Computed:            true,
+						Default:             objectdefault.StaticValue(defaultAuthPolicyExtJWT),
+						Attributes: map[string]schema.Attribute{
+							"allowed": schema.BoolAttribute{
+								MarkdownDescription: "Controls whether external JWT authentication is allowed(default true)",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+							},
+							"allowed_signers": schema.ListAttribute{
+								ElementType:         types.StringType,
+								MarkdownDescription: "A list of external JWT signer ids allowed to authenticate. Empty list allows any signer",
+								Optional:            true,
+								Computed:            true,
+								Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+							},
+						},
+					},
+				},
+			},
+			"secondary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Secondary authentication requirements of the policy",
+				Optional:            true,
+				Computed:            true,
+				Default:             objectdefault.StaticValue(defaultAuthPolicySecondary),
+				Attributes: map[string]schema.Attribute{
+					"require_totp": schema.BoolAttribute{
+						MarkdownDescription: "Controls whether TOTP MFA is required(default false)",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"require_ext_jwt_signer": schema.StringAttribute{
+						MarkdownDescription: "An id of an external JWT signer whose token is required as a secondary factor",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiAuthPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiAuthPolicyResourceModel) ToAuthPolicyCreate(ctx context.Context) (rest_model.AuthPolicyCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var primary AuthPolicyPrimaryObject
+	diags.Append(r.Primary.As(ctx, &primary, basetypes.ObjectAsOptions{})...)
+	var cert AuthPolicyCertObject
+	diags.Append(primary.Cert.As(ctx, &cert, basetypes.ObjectAsOptions{})...)
+	var updb AuthPolicyUpdbObject
+	diags.Append(primary.Updb.As(ctx, &updb, basetypes.ObjectAsOptions{})...)
+	var extJwt AuthPolicyExtJWTObject
+	diags.Append(primary.ExtJWT.As(ctx, &extJwt, basetypes.ObjectAsOptions{})...)
+	var secondary AuthPolicySecondaryObject
+	diags.Append(r.Secondary.As(ctx, &secondary, basetypes.ObjectAsOptions{})...)
+
+	name := r.Name.ValueString()
+	allowedSigners := ElementsToListOfStrings(extJwt.AllowedSigners.Elements())
+	if allowedSigners == nil {
+		allowedSigners = []string{}
+	}
+
+	authPolicyCreate := rest_model.AuthPolicyCreate{
+		Name: &name,
+		Primary: &rest_model.AuthPolicyPrimary{
+			Cert: &rest_model.AuthPolicyPrimaryCert{
+				Allowed:           cert.Allowed.ValueBoolPointer(),
+				AllowExpiredCerts: cert.AllowExpiredCerts.ValueBoolPointer(),
+			},
+			Updb: &rest_model.AuthPolicyPrimaryUpdb{
+				Allowed:                updb.Allowed.ValueBoolPointer(),
+				MinPasswordLength:      updb.MinPasswordLength.ValueInt64Pointer(),
+				RequireMixedCase:       updb.RequireMixedCase.ValueBoolPointer(),
+				RequireNumberChar:      updb.RequireNumberChar.ValueBoolPointer(),
+				RequireSpecialChar:     updb.RequireSpecialChar.ValueBoolPointer(),
+				MaxAttempts:            updb.MaxAttempts.ValueInt64Pointer(),
+				LockoutDurationMinutes: updb.LockoutDurationMinutes.ValueInt64Pointer(),
+			},
+			ExtJWT: &rest_model.AuthPolicyPrimaryExtJWT{
+				Allowed:        extJwt.Allowed.ValueBoolPointer(),
+				AllowedSigners: allowedSigners,
+			},
+		},
+		Secondary: &rest_model.AuthPolicySecondary{
+			RequireTotp:         secondary.RequireTotp.ValueBoolPointer(),
+			RequireExtJWTSigner: secondary.RequireExtJWTSigner.ValueStringPointer(),
+		},
+		Tags: TagsFromAttributes(r.Tags.Elements()),
+	}
+
+	return authPolicyCreate, diags
+}
+
+func AuthPolicyPrimaryToObject(ctx context.Context, primary *rest_model.AuthPolicyPrimary) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if primary == nil {
+		return types.ObjectNull(AuthPolicyPrimaryModel.AttrTypes), diags
+	}
+
+	primaryObject := AuthPolicyPrimaryObject{
+		Cert:   types.ObjectNull(AuthPolicyCertModel.AttrTypes),
+		Updb:   types.ObjectNull(AuthPolicyUpdbModel.AttrTypes),
+		ExtJWT: types.ObjectNull(AuthPolicyExtJWTModel.AttrTypes),
+	}
+
+	if primary.Cert != nil {
+		cert, d := types.ObjectValueFrom(ctx, AuthPolicyCertModel.AttrTypes, AuthPolicyCertObject{
+			Allowed:           types.BoolPointerValue(primary.Cert.Allowed),
+			AllowExpiredCerts: types.BoolPointerValue(primary.Cert.AllowExpiredCerts),
+		})
+		diags.Append(d...)
+		primaryObject.Cert = cert
+	}
+
+	if primary.Updb != nil {
+		updb, d := types.ObjectValueFrom(ctx, AuthPolicyUpdbModel.AttrTypes, AuthPolicyUpdbObject{
+			Allowed:                types.BoolPointerValue(primary.Updb.Allowed),
+			MinPasswordLength:      types.Int64PointerValue(primary.Updb.MinPasswordLength),
+			RequireMixedCase:       types.BoolPointerValue(primary.Updb.RequireMixedCase),
+			RequireNumberChar:      types.BoolPointerValue(primary.Updb.RequireNumberChar),
+			RequireSpecialChar:     types.BoolPointerValue(primary.Updb.RequireSpecialChar),
+			MaxAttempts:            types.Int64PointerValue(primary.Updb.MaxAttempts),
+			LockoutDurationMinutes: types.Int64PointerValue(primary.Updb.LockoutDurationMinutes),
+		})
+		diags.Append(d...)
+		primaryObject.Updb = updb
+	}
+
+	if primary.ExtJWT != nil {
+		allowedSigners, d := NativeListToTerraformTypedList(ctx, types.StringType, primary.ExtJWT.AllowedSigners)
+		diags.Append(d...)
+		extJwt, d := types.ObjectValueFrom(ctx, AuthPolicyExtJWTModel.AttrTypes, AuthPolicyExtJWTObject{
+			Allowed:        types.BoolPointerValue(primary.ExtJWT.Allowed),
+			AllowedSigners: allowedSigners,
+		})
+		diags.Append(d...)
+		primaryObject.ExtJWT = extJwt
+	}
+
+	result, d := types.ObjectValueFrom(ctx, AuthPolicyPrimaryModel.AttrTypes, primaryObject)
+	diags.Append(d...)
+	return result, diags
+}
+
+func AuthPolicySecondaryToObject(ctx context.Context, secondary *rest_model.AuthPolicySecondary) (types.Object, diag.Diagnostics) {
+	if secondary == nil {
+		return types.ObjectNull(AuthPolicySecondaryModel.AttrTypes), nil
+	}
+
+	requireExtJwtSigner := types.StringPointerValue(secondary.RequireExtJWTSigner)
+	if requireExtJwtSigner.ValueString() == "" {
+		requireExtJwtSigner = types.StringNull()
+	}
+
+	return types.ObjectValueFrom(ctx, AuthPolicySecondaryModel.AttrTypes, AuthPolicySecondaryObject{
+		RequireTotp:         types.BoolPointerValue(secondary.RequireTotp),
+		RequireExtJWTSigner: requireExtJwtSigner,
+	})
+}
+
+func (r *ZitiAuthPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiAuthPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authPolicyCreate, diags := plan.ToAuthPolicyCreate(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := auth_policy.NewCreateAuthPolicyParams()
+	params.AuthPolicy = &authPolicyCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateAuthPolicy req")
+
+	data, err := r.client.API.AuthPolicy.CreateAuthPolicy(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Auth Policy from API",
+			"Could not create Ziti Auth Policy "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiAuthPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiAuthPolicyResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Auth Policy")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := auth_policy.NewDetailAuthPolicyParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.AuthPolicy.DetailAuthPolicy(params, nil)
+	if _, ok := err.(*auth_policy.DetailAuthPolicyNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Auth Policy from API",
+			"Could not read Ziti Auth Policy ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	authPolicy := data.Payload.Data
+
+	state.Name = types.StringValue(*authPolicy.Name)
+
+	primary, diags := AuthPolicyPrimaryToObject(ctx, authPolicy.Primary)
+	resp.Diagnostics.Append(diags...)
+	state.Primary = primary
+
+	secondary, diags := AuthPolicySecondaryToObject(ctx, authPolicy.Secondary)
+	resp.Diagnostics.Append(diags...)
+	state.Secondary = secondary
+
+	if len(authPolicy.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, authPolicy.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiAuthPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiAuthPolicyResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Auth Policy")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authPolicyCreate, diags := plan.ToAuthPolicyCreate(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := auth_policy.NewUpdateAuthPolicyParams()
+	params.ID = plan.ID.ValueString()
+	params.AuthPolicy = &rest_model.AuthPolicyUpdate{
+		AuthPolicyCreate: authPolicyCreate,
+	}
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateAuthPolicy req")
+
+	_, err := r.client.API.AuthPolicy.UpdateAuthPolicy(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Auth Policy from API",
+			"Could not update Ziti Auth Policy "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiAuthPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiAuthPolicyResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Auth Policy")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := auth_policy.NewDeleteAuthPolicyParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.AuthPolicy.DeleteAuthPolicy(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Auth Policy from API",
+			"Could not delete Ziti Auth Policy "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiAuthPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
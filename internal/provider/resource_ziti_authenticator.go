@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/authenticator"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiAuthenticatorResource{}
+var _ resource.ResourceWithImportState = &ZitiAuthenticatorResource{}
+var _ resource.ResourceWithValidateConfig = &ZitiAuthenticatorResource{}
+
+func NewZitiAuthenticatorResource() resource.Resource {
+	return &ZitiAuthenticatorResource{}
+}
+
+// ZitiAuthenticatorResource defines the resource implementation.
+type ZitiAuthenticatorResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiAuthenticatorResourceModel describes the resource data model.
+type ZitiAuthenticatorResourceModel struct {
+	IdentityID      types.String `tfsdk:"identity_id"`
+	Method          types.String `tfsdk:"method"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	CertPem         types.String `tfsdk:"cert_pem"`
+	Tags            types.Map    `tfsdk:"tags"`
+
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func (r *ZitiAuthenticatorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authenticator"
+}
+
+func (r *ZitiAuthenticatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an authenticator of a Ziti identity. A password is re-keyed in place, while a changed certificate replaces the authenticator",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the authenticator",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to authenticate. Changing it forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "A method of the authenticator: updb or cert. Changing it forces a new resource to be created",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("updb", "cert"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "A username of the identity. Required for the updb method",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "A write-only password of the identity, which requires Terraform 1.11 or later. Required for the updb method. It is neither stored in the state nor read back from the controller, so the authenticator is only re-keyed with it when password_version changes",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(5, 100),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "A version of the password. Changing it re-keys the authenticator with the configured password",
+				Optional:            true,
+			},
+			"cert_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded client certificate of the identity. Required for the cert method. Changing it forces a new resource to be created",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the authenticator",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the certificate of the authenticator",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiAuthenticatorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiAuthenticatorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ZitiAuthenticatorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Method.IsNull() || config.Method.IsUnknown() {
+		return
+	}
+
+	isUpdb := config.Method.ValueString() == "updb"
+	for name, value := range map[string]types.String{"username": config.Username, "password": config.Password} {
+		if isUpdb && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Authenticator "+name, name+" is required when the authenticator method is updb")
+		} else if !isUpdb && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unexpected Authenticator "+name, name+" can only be set when the authenticator method is updb")
+		}
+	}
+	if !isUpdb && config.CertPem.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("cert_pem"), "Missing Authenticator Certificate", "cert_pem is required when the authenticator method is cert")
+	} else if isUpdb && !config.CertPem.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("cert_pem"), "Unexpected Authenticator Certificate", "cert_pem can only be set when the authenticator method is cert")
+	}
+}
+
+func (state *ZitiAuthenticatorResourceModel) SetFromDetail(detail *rest_model.AuthenticatorDetail) {
+	state.IdentityID = types.StringPointerValue(detail.IdentityID)
+	state.Method = types.StringPointerValue(detail.Method)
+	state.Username = stringOrNull(&detail.Username)
+	state.Fingerprint = stringOrNull(&detail.Fingerprint)
+
+	// Keep the configured formatting of the certificate as long as it is the same one.
+	if strings.TrimSpace(state.CertPem.ValueString()) != strings.TrimSpace(detail.CertPem) {
+		state.CertPem = stringOrNull(&detail.CertPem)
+	}
+}
+
+func (r *ZitiAuthenticatorResource) readAuthenticator(id string) (*rest_model.AuthenticatorDetail, error) {
+	params := authenticator.NewDetailAuthenticatorParams()
+	params.ID = id
+	data, err := r.client.API.Authenticator.DetailAuthenticator(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return data.Payload.Data, nil
+}
+
+func (r *ZitiAuthenticatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiAuthenticatorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is write-only, so it is only available in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityId := plan.IdentityID.ValueString()
+	method := plan.Method.ValueString()
+	authenticatorCreate := rest_model.AuthenticatorCreate{
+		IdentityID: &identityId,
+		Method:     &method,
+		Username:   plan.Username.ValueString(),
+		Password:   password.ValueString(),
+		CertPem:    plan.CertPem.ValueString(),
+		Tags:       TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := authenticator.NewCreateAuthenticatorParams()
+	params.Authenticator = &authenticatorCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateAuthenticator req")
+
+	data, err := r.client.API.Authenticator.CreateAuthenticator(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Authenticator from API",
+			"Could not create Ziti Authenticator for identity "+identityId+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	detail, err := r.readAuthenticator(plan.ID.ValueString())
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Authenticator from API",
+			"Could not read Ziti Authenticator "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+	plan.Fingerprint = stringOrNull(&detail.Fingerprint)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiAuthenticatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiAuthenticatorResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Authenticator")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detail, err := r.readAuthenticator(state.ID.ValueString())
+	if _, ok := err.(*authenticator.DetailAuthenticatorNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Authenticator from API",
+			"Could not read Ziti Authenticator ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.SetFromDetail(detail)
+
+	if len(detail.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, detail.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiAuthenticatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiAuthenticatorResourceModel
+	var state ZitiAuthenticatorResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Authenticator")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authenticatorPatch := rest_model.AuthenticatorPatch{
+		Tags: TagsFromAttributes(plan.Tags.Elements()),
+	}
+	if plan.Method.ValueString() == "updb" {
+		username := rest_model.UsernameNullable(plan.Username.ValueString())
+		authenticatorPatch.Username = &username
+
+		// The password cannot be compared with the previous one, so it is only sent when its version changes.
+		if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+			var configPassword types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &configPassword)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			password := rest_model.PasswordNullable(configPassword.ValueString())
+			authenticatorPatch.Password = &password
+		}
+	}
+
+	params := authenticator.NewPatchAuthenticatorParams()
+	params.ID = plan.ID.ValueString()
+	params.Authenticator = &authenticatorPatch
+
+	tflog.Debug(ctx, "Assigned all the params. Making PatchAuthenticator req")
+
+	_, err := r.client.API.Authenticator.PatchAuthenticator(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Authenticator from API",
+			"Could not update Ziti Authenticator "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiAuthenticatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiAuthenticatorResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Authenticator")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := authenticator.NewDeleteAuthenticatorParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Authenticator.DeleteAuthenticator(params, nil)
+	if _, ok := err.(*authenticator.DeleteAuthenticatorNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Authenticator from API",
+			"Could not delete Ziti Authenticator "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiAuthenticatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
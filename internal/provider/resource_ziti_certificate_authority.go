@@ -0,0 +1,641 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/certificate_authority"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiCertificateAuthorityResource{}
+var _ resource.ResourceWithImportState = &ZitiCertificateAuthorityResource{}
+
+func NewZitiCertificateAuthorityResource() resource.Resource {
+	return &ZitiCertificateAuthorityResource{}
+}
+
+// ZitiCertificateAuthorityResource defines the resource implementation.
+type ZitiCertificateAuthorityResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+var CaExternalIDClaimModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"location":         types.StringType,
+		"matcher":          types.StringType,
+		"matcher_criteria": types.StringType,
+		"parser":           types.StringType,
+		"parser_criteria":  types.StringType,
+		"index":            types.Int64Type,
+	},
+}
+
+type CaExternalIDClaimObject struct {
+	Location        types.String `tfsdk:"location"`
+	Matcher         types.String `tfsdk:"matcher"`
+	MatcherCriteria types.String `tfsdk:"matcher_criteria"`
+	Parser          types.String `tfsdk:"parser"`
+	ParserCriteria  types.String `tfsdk:"parser_criteria"`
+	Index           types.Int64  `tfsdk:"index"`
+}
+
+// ZitiCertificateAuthorityResourceModel describes the resource data model.
+type ZitiCertificateAuthorityResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name                      types.String `tfsdk:"name"`
+	CertPem                   types.String `tfsdk:"cert_pem"`
+	PrivateKeyPem             types.String `tfsdk:"private_key_pem"`
+	IsAutoCaEnrollmentEnabled types.Bool   `tfsdk:"is_auto_ca_enrollment_enabled"`
+	IsOttCaEnrollmentEnabled  types.Bool   `tfsdk:"is_ott_ca_enrollment_enabled"`
+	IsAuthEnabled             types.Bool   `tfsdk:"is_auth_enabled"`
+	IdentityRoles             types.List   `tfsdk:"identity_roles"`
+	IdentityNameFormat        types.String `tfsdk:"identity_name_format"`
+	ExternalIDClaim           types.Object `tfsdk:"external_id_claim"`
+	Tags                      types.Map    `tfsdk:"tags"`
+
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	IsVerified  types.Bool   `tfsdk:"is_verified"`
+}
+
+func (r *ZitiCertificateAuthorityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_authority"
+}
+
+func (r *ZitiCertificateAuthorityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a certificate authority of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the certificate authority",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the certificate authority",
+				Required:            true,
+			},
+			"cert_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded certificate of the certificate authority. Changing it forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded private key of the certificate authority. When set, the certificate authority is verified right after it is created. " +
+					"If the verification fails, the certificate authority is tainted and replaced on the next apply. The key never leaves the provider",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"is_auto_ca_enrollment_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether identities are created upon the first authentication with a certificate signed by the certificate authority(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_ott_ca_enrollment_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether identities can be enrolled with a one time token and a certificate signed by the certificate authority(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_auth_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether certificates signed by the certificate authority can be used to authenticate(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"identity_roles": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of role attributes assigned to identities created by auto CA enrollment",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+			},
+			"identity_name_format": schema.StringAttribute{
+				MarkdownDescription: "A format of the names of identities created by auto CA enrollment(default \"[caName]-[commonName]\")",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("[caName]-[commonName]"),
+			},
+			"external_id_claim": schema.SingleNestedAttribute{
+				MarkdownDescription: "A mapping of a certificate field to the `external_id` of identities",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"location": schema.StringAttribute{
+						MarkdownDescription: "A certificate field to take the external id from",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("COMMON_NAME", "SAN_URI", "SAN_EMAIL"),
+						},
+					},
+					"matcher": schema.StringAttribute{
+						MarkdownDescription: "A way to match values of the location",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ALL", "PREFIX", "SUFFIX", "SCHEME"),
+						},
+					},
+					"matcher_criteria": schema.StringAttribute{
+						MarkdownDescription: "A criteria of the matcher. Ignored for the ALL matcher",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"parser": schema.StringAttribute{
+						MarkdownDescription: "A way to parse the matched value",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("NONE", "SPLIT"),
+						},
+					},
+					"parser_criteria": schema.StringAttribute{
+						MarkdownDescription: "A criteria of the parser. Ignored for the NONE parser",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"index": schema.Int64Attribute{
+						MarkdownDescription: "An index of the value to use when several values are matched(default 0)",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the certificate authority",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the certificate authority certificate",
+				Computed:            true,
+			},
+			"is_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the possession of the certificate authority private key has been verified",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ZitiCertificateAuthorityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiCertificateAuthorityResourceModel) externalIDClaim(ctx context.Context) (*rest_model.ExternalIDClaim, diag.Diagnostics) {
+	if r.ExternalIDClaim.IsNull() || r.ExternalIDClaim.IsUnknown() {
+		return nil, nil
+	}
+
+	var claim CaExternalIDClaimObject
+	diags := r.ExternalIDClaim.As(ctx, &claim, basetypes.ObjectAsOptions{})
+
+	return &rest_model.ExternalIDClaim{
+		Location:        claim.Location.ValueStringPointer(),
+		Matcher:         claim.Matcher.ValueStringPointer(),
+		MatcherCriteria: claim.MatcherCriteria.ValueStringPointer(),
+		Parser:          claim.Parser.ValueStringPointer(),
+		ParserCriteria:  claim.ParserCriteria.ValueStringPointer(),
+		Index:           claim.Index.ValueInt64Pointer(),
+	}, diags
+}
+
+func (state *ZitiCertificateAuthorityResourceModel) SetFromDetail(ctx context.Context, detail *rest_model.CaDetail) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Name = types.StringPointerValue(detail.Name)
+
+	// The controller may re-encode the PEM, keep the configured value as long as it holds the same certificates.
+	if detail.CertPem != nil && !CertificatesPemEqual(*detail.CertPem, state.CertPem.ValueString()) {
+		state.CertPem = types.StringValue(*detail.CertPem)
+	}
+
+	state.IsAutoCaEnrollmentEnabled = types.BoolPointerValue(detail.IsAutoCaEnrollmentEnabled)
+	state.IsOttCaEnrollmentEnabled = types.BoolPointerValue(detail.IsOttCaEnrollmentEnabled)
+	state.IsAuthEnabled = types.BoolPointerValue(detail.IsAuthEnabled)
+
+	identityRoles, d := NativeListToTerraformTypedList(ctx, types.StringType, detail.IdentityRoles)
+	diags.Append(d...)
+	state.IdentityRoles = identityRoles
+
+	state.IdentityNameFormat = types.StringPointerValue(detail.IdentityNameFormat)
+
+	if detail.ExternalIDClaim != nil {
+		claimObject := CaExternalIDClaimObject{
+			Location:        types.StringPointerValue(detail.ExternalIDClaim.Location),
+			Matcher:         types.StringPointerValue(detail.ExternalIDClaim.Matcher),
+			MatcherCriteria: types.StringValue(""),
+			Parser:          types.StringPointerValue(detail.ExternalIDClaim.Parser),
+			ParserCriteria:  types.StringValue(""),
+			Index:           types.Int64Value(0),
+		}
+		if detail.ExternalIDClaim.MatcherCriteria != nil {
+			claimObject.MatcherCriteria = types.StringValue(*detail.ExternalIDClaim.MatcherCriteria)
+		}
+		if detail.ExternalIDClaim.ParserCriteria != nil {
+			claimObject.ParserCriteria = types.StringValue(*detail.ExternalIDClaim.ParserCriteria)
+		}
+		if detail.ExternalIDClaim.Index != nil {
+			claimObject.Index = types.Int64Value(*detail.ExternalIDClaim.Index)
+		}
+		claim, d := types.ObjectValueFrom(ctx, CaExternalIDClaimModel.AttrTypes, claimObject)
+		diags.Append(d...)
+		state.ExternalIDClaim = claim
+	} else {
+		state.ExternalIDClaim = types.ObjectNull(CaExternalIDClaimModel.AttrTypes)
+	}
+
+	if len(detail.BaseEntity.Tags.SubTags) != 0 {
+		tags, d := types.MapValueFrom(ctx, types.StringType, detail.BaseEntity.Tags.SubTags)
+		diags.Append(d...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	state.Fingerprint = types.StringPointerValue(detail.Fingerprint)
+	state.IsVerified = types.BoolPointerValue(detail.IsVerified)
+
+	return diags
+}
+
+// SignCaVerificationCertificate issues a short-lived certificate for the verification token,
+// signed by the certificate authority, which proves the possession of its private key.
+// CertificatesPemEqual reports whether two PEM documents hold the same certificates, regardless of their encoding.
+func CertificatesPemEqual(a string, b string) bool {
+	derA, derB := certificatesDer(a), certificatesDer(b)
+	if len(derA) == 0 || len(derA) != len(derB) {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	for i := range derA {
+		if !bytes.Equal(derA[i], derB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func certificatesDer(certsPem string) [][]byte {
+	var ders [][]byte
+	rest := []byte(certsPem)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return ders
+		}
+		if block.Type == "CERTIFICATE" {
+			ders = append(ders, block.Bytes)
+		}
+	}
+}
+
+func SignCaVerificationCertificate(caCertPem string, caKeyPem string, verificationToken string) (string, error) {
+	block, _ := pem.Decode([]byte(caCertPem))
+	if block == nil {
+		return "", errors.New("no PEM block found in the certificate authority certificate")
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("could not parse the certificate authority certificate: %w", err)
+	}
+
+	caKey, err := ParsePrivateKeyPem(caKeyPem)
+	if err != nil {
+		return "", fmt.Errorf("could not parse the certificate authority private key: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: verificationToken},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return "", fmt.Errorf("could not sign the verification certificate: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+func (r *ZitiCertificateAuthorityResource) detailCa(id string) (*rest_model.CaDetail, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := certificate_authority.NewDetailCaParams()
+	params.ID = id
+	data, err := r.client.API.CertificateAuthority.DetailCa(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		diags.AddError(
+			"Error Reading Ziti Certificate Authority from API",
+			"Could not read Ziti Certificate Authority ID "+id+": "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return data.Payload.Data, diags
+}
+
+// verifyCa proves the possession of the certificate authority private key to the controller,
+// unless the certificate authority is already verified.
+func (r *ZitiCertificateAuthorityResource) verifyCa(ctx context.Context, plan *ZitiCertificateAuthorityResourceModel) diag.Diagnostics {
+	detail, diags := r.detailCa(plan.ID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	if plan.PrivateKeyPem.ValueString() != "" && (detail.IsVerified == nil || !*detail.IsVerified) {
+		certificate, err := SignCaVerificationCertificate(plan.CertPem.ValueString(), plan.PrivateKeyPem.ValueString(), detail.VerificationToken.String())
+		if err != nil {
+			diags.AddError(
+				"Error Verifying Ziti Certificate Authority",
+				"Could not sign a verification certificate for Ziti Certificate Authority "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return diags
+		}
+
+		params := certificate_authority.NewVerifyCaParams()
+		params.ID = plan.ID.ValueString()
+		params.Certificate = certificate
+
+		tflog.Debug(ctx, "Signed the verification certificate. Making VerifyCa req")
+
+		_, err = r.client.API.CertificateAuthority.VerifyCa(params, nil)
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			diags.AddError(
+				"Error Verifying Ziti Certificate Authority from API",
+				"Could not verify Ziti Certificate Authority "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return diags
+		}
+
+		detail, diags = r.detailCa(plan.ID.ValueString())
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(plan.SetFromDetail(ctx, detail)...)
+	return diags
+}
+
+func (r *ZitiCertificateAuthorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiCertificateAuthorityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalIDClaim, diags := plan.externalIDClaim(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityRoles := rest_model.Roles(ElementsToListOfStrings(plan.IdentityRoles.Elements()))
+	if identityRoles == nil {
+		identityRoles = rest_model.Roles{}
+	}
+
+	caCreate := rest_model.CaCreate{
+		Name:                      plan.Name.ValueStringPointer(),
+		CertPem:                   plan.CertPem.ValueStringPointer(),
+		IsAutoCaEnrollmentEnabled: plan.IsAutoCaEnrollmentEnabled.ValueBoolPointer(),
+		IsOttCaEnrollmentEnabled:  plan.IsOttCaEnrollmentEnabled.ValueBoolPointer(),
+		IsAuthEnabled:             plan.IsAuthEnabled.ValueBoolPointer(),
+		IdentityRoles:             identityRoles,
+		IdentityNameFormat:        plan.IdentityNameFormat.ValueString(),
+		ExternalIDClaim:           externalIDClaim,
+		Tags:                      TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := certificate_authority.NewCreateCaParams()
+	params.Ca = &caCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateCa req")
+
+	data, err := r.client.API.CertificateAuthority.CreateCa(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Certificate Authority from API",
+			"Could not create Ziti Certificate Authority "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	resp.Diagnostics.Append(r.verifyCa(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		// Keep the created certificate authority in the state so it is not orphaned.
+		// Terraform marks it as tainted, so it is replaced on the next apply.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiCertificateAuthorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiCertificateAuthorityResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Certificate Authority")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := certificate_authority.NewDetailCaParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.CertificateAuthority.DetailCa(params, nil)
+	if _, ok := err.(*certificate_authority.DetailCaNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Certificate Authority from API",
+			"Could not read Ziti Certificate Authority ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.SetFromDetail(ctx, data.Payload.Data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiCertificateAuthorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiCertificateAuthorityResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Certificate Authority")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalIDClaim, diags := plan.externalIDClaim(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityRoles := rest_model.Roles(ElementsToListOfStrings(plan.IdentityRoles.Elements()))
+	if identityRoles == nil {
+		identityRoles = rest_model.Roles{}
+	}
+
+	caUpdate := rest_model.CaUpdate{
+		Name:                      plan.Name.ValueStringPointer(),
+		IsAutoCaEnrollmentEnabled: plan.IsAutoCaEnrollmentEnabled.ValueBoolPointer(),
+		IsOttCaEnrollmentEnabled:  plan.IsOttCaEnrollmentEnabled.ValueBoolPointer(),
+		IsAuthEnabled:             plan.IsAuthEnabled.ValueBoolPointer(),
+		IdentityRoles:             identityRoles,
+		IdentityNameFormat:        plan.IdentityNameFormat.ValueStringPointer(),
+		ExternalIDClaim:           externalIDClaim,
+		Tags:                      TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := certificate_authority.NewUpdateCaParams()
+	params.ID = plan.ID.ValueString()
+	params.Ca = &caUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateCa req")
+
+	_, err := r.client.API.CertificateAuthority.UpdateCa(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Certificate Authority from API",
+			"Could not update Ziti Certificate Authority "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// A private key may have been supplied after the certificate authority was created.
+	resp.Diagnostics.Append(r.verifyCa(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiCertificateAuthorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiCertificateAuthorityResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Certificate Authority")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := certificate_authority.NewDeleteCaParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.CertificateAuthority.DeleteCa(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Certificate Authority from API",
+			"Could not delete Ziti Certificate Authority "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiCertificateAuthorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiConfigResource{}
+var _ resource.ResourceWithImportState = &ZitiConfigResource{}
+var _ resource.ResourceWithModifyPlan = &ZitiConfigResource{}
+
+func NewZitiConfigResource() resource.Resource {
+	return &ZitiConfigResource{}
+}
+
+// ZitiConfigResource defines the resource implementation.
+type ZitiConfigResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiConfigResourceModel describes the resource data model.
+type ZitiConfigResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name         types.String         `tfsdk:"name"`
+	ConfigType   types.String         `tfsdk:"config_type"`
+	ConfigTypeID types.String         `tfsdk:"config_type_id"`
+	Data         jsontypes.Normalized `tfsdk:"data"`
+	Tags         types.Map            `tfsdk:"tags"`
+}
+
+func (r *ZitiConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (r *ZitiConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a config of any config type of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the config",
+				Required:            true,
+			},
+			"config_type": schema.StringAttribute{
+				MarkdownDescription: "Id or name of the config type. Changing it forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config_type_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config type",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "A JSON encoded data of the config. Use `jsonencode` to set it. It is validated against the schema of the config type during the plan",
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the config",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+		},
+	}
+}
+
+func (r *ZitiConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate upon destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ZitiConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ConfigType.IsUnknown() || plan.Data.IsUnknown() {
+		return
+	}
+
+	configType, err := ResolveConfigType(r.client, plan.ConfigType.ValueString())
+	if err != nil {
+		// The config type may be created within the same apply, it will be validated by the controller then.
+		tflog.Debug(ctx, "Skipping the validation of Ziti Config data: "+err.Error())
+		return
+	}
+	schema := ConfigTypeSchema(configType)
+	if len(schema) == 0 {
+		return
+	}
+
+	violations, err := ValidateJsonSchema(schema, plan.Data.ValueString())
+	if err != nil {
+		// The controller reports the schema or the data it cannot validate.
+		tflog.Debug(ctx, "Skipping the validation of Ziti Config data: "+err.Error())
+		return
+	}
+	if len(violations) != 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Invalid Ziti Config data",
+			"The data does not match the schema of the config type "+*configType.Name+":\n"+strings.Join(violations, "\n"),
+		)
+	}
+}
+
+func (r *ZitiConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := DecodeJson(plan.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Error unmarshalling Ziti Config data",
+			"Could not parse the data of Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	configType, err := ResolveConfigType(r.client, plan.ConfigType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not find the config type "+plan.ConfigType.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.ConfigTypeID = types.StringValue(*configType.ID)
+
+	name := plan.Name.ValueString()
+	configTypeId := plan.ConfigTypeID.ValueString()
+	configCreate := rest_model.ConfigCreate{
+		ConfigTypeID: &configTypeId,
+		Name:         &name,
+		Data:         data,
+		Tags:         TagsFromAttributes(plan.Tags.Elements()),
+	}
+	params := config.NewCreateConfigParams()
+	params.Config = &configCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfig req")
+
+	created, err := r.client.API.Config.CreateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(created.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiConfigResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfig(params, nil)
+	if _, ok := err.(*config.DetailConfigNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	configDetail := data.Payload.Data
+
+	state.Name = types.StringValue(*configDetail.Name)
+	state.ConfigTypeID = types.StringPointerValue(configDetail.ConfigTypeID)
+
+	// Keep the config type the way it was set, unless it does not refer to the actual one anymore.
+	configTypeName := ""
+	if configDetail.ConfigType != nil {
+		configTypeName = configDetail.ConfigType.Name
+	}
+	if state.ConfigType.ValueString() != state.ConfigTypeID.ValueString() && state.ConfigType.ValueString() != configTypeName {
+		state.ConfigType = state.ConfigTypeID
+	}
+
+	dataJson, err := json.Marshal(configDetail.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config data",
+			"Could not marshal the data of Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	// The configured formatting and key order are kept as long as the data is semantically equal.
+	state.Data = jsontypes.NewNormalizedValue(string(dataJson))
+
+	if len(configDetail.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, configDetail.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiConfigResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Config")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := DecodeJson(plan.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Error unmarshalling Ziti Config data",
+			"Could not parse the data of Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configUpdate := rest_model.ConfigUpdate{
+		Name: &name,
+		Data: data,
+		Tags: TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := config.NewUpdateConfigParams()
+	params.ID = plan.ID.ValueString()
+	params.Config = &configUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateConfig req")
+
+	_, err = r.client.API.Config.UpdateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiConfigResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config from API",
+			"Could not delete Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiConfigTypeResource{}
+var _ resource.ResourceWithImportState = &ZitiConfigTypeResource{}
+
+func NewZitiConfigTypeResource() resource.Resource {
+	return &ZitiConfigTypeResource{}
+}
+
+// ZitiConfigTypeResource defines the resource implementation.
+type ZitiConfigTypeResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiConfigTypeResourceModel describes the resource data model.
+type ZitiConfigTypeResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name   types.String         `tfsdk:"name"`
+	Schema jsontypes.Normalized `tfsdk:"schema"`
+	Tags   types.Map            `tfsdk:"tags"`
+}
+
+// configTypeIdKey identifies a config type looked up by name through a client, ie for a provider instance.
+type configTypeIdKey struct {
+	client *edge_apis.ManagementApiClient
+	name   string
+}
+
+// configTypeIds caches the ids of config types looked up by name, which do not change for a controller.
+var configTypeIds sync.Map
+
+// GetConfigTypeIdByName looks up an id of a config type, since ids of the built-in config types differ between controllers.
+func GetConfigTypeIdByName(client *edge_apis.ManagementApiClient, name string) (string, error) {
+	key := configTypeIdKey{client: client, name: name}
+	if id, ok := configTypeIds.Load(key); ok {
+		return id.(string), nil
+	}
+
+	params := config.NewListConfigTypesParams()
+	var limit int64 = 1
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := "name = " + ZitiQlString(name)
+	params.Filter = &filter
+
+	data, err := client.API.Config.ListConfigTypes(params, nil)
+	if err != nil {
+		return "", rest_util.WrapErr(err)
+	}
+	if len(data.Payload.Data) == 0 {
+		return "", fmt.Errorf("config type %q does not exist", name)
+	}
+
+	id := *data.Payload.Data[0].ID
+	configTypeIds.Store(key, id)
+	return id, nil
+}
+
+// ResolveConfigType looks up a config type by either its id or its name.
+func ResolveConfigType(client *edge_apis.ManagementApiClient, idOrName string) (*rest_model.ConfigTypeDetail, error) {
+	params := config.NewListConfigTypesParams()
+	var limit int64 = 1
+	var offset int64 = 0
+	params.Limit = &limit
+	params.Offset = &offset
+	filter := "id = " + ZitiQlString(idOrName) + " or name = " + ZitiQlString(idOrName)
+	params.Filter = &filter
+
+	data, err := client.API.Config.ListConfigTypes(params, nil)
+	if err != nil {
+		return nil, rest_util.WrapErr(err)
+	}
+	if len(data.Payload.Data) == 0 {
+		return nil, fmt.Errorf("config type %q does not exist", idOrName)
+	}
+
+	return data.Payload.Data[0], nil
+}
+
+// ConfigTypeSchema returns the JSON schema of a config type, or nil when it has none.
+func ConfigTypeSchema(configType *rest_model.ConfigTypeDetail) map[string]interface{} {
+	schema, _ := configType.Schema.(map[string]interface{})
+	return schema
+}
+
+func (r *ZitiConfigTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_type"
+}
+
+func (r *ZitiConfigTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a config type of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the config type",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the config type",
+				Required:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "A JSON schema configs of the type are validated against. Use `jsonencode` to set it",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the config type",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+		},
+	}
+}
+
+func (r *ZitiConfigTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiConfigTypeResourceModel) schemaObject() (map[string]interface{}, error) {
+	if r.Schema.ValueString() == "" {
+		return nil, nil
+	}
+
+	var schemaObject map[string]interface{}
+	err := json.Unmarshal([]byte(r.Schema.ValueString()), &schemaObject)
+	return schemaObject, err
+}
+
+func (r *ZitiConfigTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiConfigTypeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaObject, err := plan.schemaObject()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema"),
+			"Error unmarshalling Ziti Config Type schema",
+			"Could not parse the schema of Ziti Config Type "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configTypeCreate := rest_model.ConfigTypeCreate{
+		Name:   &name,
+		Schema: schemaObject,
+		Tags:   TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := config.NewCreateConfigTypeParams()
+	params.ConfigType = &configTypeCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfigType req")
+
+	data, err := r.client.API.Config.CreateConfigType(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config Type from API",
+			"Could not create Ziti Config Type "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiConfigTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiConfigTypeResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Config Type")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigTypeParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfigType(params, nil)
+	if _, ok := err.(*config.DetailConfigTypeNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config Type from API",
+			"Could not read Ziti Config Type ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	configType := data.Payload.Data
+
+	state.Name = types.StringValue(*configType.Name)
+
+	if schema := ConfigTypeSchema(configType); len(schema) != 0 {
+		schemaJson, err := json.Marshal(schema)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error marshalling Ziti Config Type schema",
+				"Could not marshal the schema of Ziti Config Type "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		// The configured formatting is kept as long as the schema is semantically equal.
+		state.Schema = jsontypes.NewNormalizedValue(string(schemaJson))
+	} else {
+		state.Schema = jsontypes.NewNormalizedNull()
+	}
+
+	if len(configType.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, configType.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiConfigTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiConfigTypeResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Config Type")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaObject, err := plan.schemaObject()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema"),
+			"Error unmarshalling Ziti Config Type schema",
+			"Could not parse the schema of Ziti Config Type "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configTypeUpdate := rest_model.ConfigTypeUpdate{
+		Name:   &name,
+		Schema: schemaObject,
+		Tags:   TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := config.NewUpdateConfigTypeParams()
+	params.ID = plan.ID.ValueString()
+	params.ConfigType = &configTypeUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateConfigType req")
+
+	_, err = r.client.API.Config.UpdateConfigType(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config Type from API",
+			"Could not update Ziti Config Type "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiConfigTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiConfigTypeResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Config Type")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigTypeParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfigType(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config Type from API",
+			"Could not delete Ziti Config Type "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiConfigTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
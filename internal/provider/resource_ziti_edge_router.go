@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/edge_router"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiEdgeRouterResource{}
+var _ resource.ResourceWithImportState = &ZitiEdgeRouterResource{}
+
+func NewZitiEdgeRouterResource() resource.Resource {
+	return &ZitiEdgeRouterResource{}
+}
+
+// ZitiEdgeRouterResource defines the resource implementation.
+type ZitiEdgeRouterResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiEdgeRouterResourceModel describes the resource data model.
+type ZitiEdgeRouterResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name              types.String `tfsdk:"name"`
+	RoleAttributes    types.List   `tfsdk:"role_attributes"`
+	Cost              types.Int64  `tfsdk:"cost"`
+	NoTraversal       types.Bool   `tfsdk:"no_traversal"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	IsTunnelerEnabled types.Bool   `tfsdk:"is_tunneler_enabled"`
+	AppData           types.Map    `tfsdk:"app_data"`
+	Tags              types.Map    `tfsdk:"tags"`
+
+	EnrollmentJWT       types.String `tfsdk:"enrollment_jwt"`
+	EnrollmentCreatedAt types.String `tfsdk:"enrollment_created_at"`
+	EnrollmentExpiresAt types.String `tfsdk:"enrollment_expires_at"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	Hostname            types.String `tfsdk:"hostname"`
+	IsOnline            types.Bool   `tfsdk:"is_online"`
+	IsVerified          types.Bool   `tfsdk:"is_verified"`
+	SyncStatus          types.String `tfsdk:"sync_status"`
+}
+
+func (r *ZitiEdgeRouterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_router"
+}
+
+func (r *ZitiEdgeRouterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an edge router of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the edge router",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the edge router",
+				Required:            true,
+			},
+			"role_attributes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of role attributes",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "A cost of routing traffic through the edge router. Defaults to 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"no_traversal": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the edge router is prevented from being used as a transit hop(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the edge router is disabled(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_tunneler_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the edge router is able to act as a tunneler(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"app_data": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "AppData of the edge router",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the edge router",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+
+			"enrollment_jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the edge router with. Empty once the edge router is enrolled",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enrollment_created_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enrollment_expires_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment expires",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the edge router certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "A hostname the edge router reported to the controller",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router is currently connected to the controller",
+				Computed:            true,
+			},
+			"is_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge router has completed enrollment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sync_status": schema.StringAttribute{
+				MarkdownDescription: "A sync status of the edge router",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ZitiEdgeRouterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (state *ZitiEdgeRouterResourceModel) SetComputedFromDetail(detail *rest_model.EdgeRouterDetail) {
+	if detail.EnrollmentJWT != nil {
+		state.EnrollmentJWT = types.StringValue(*detail.EnrollmentJWT)
+	} else {
+		state.EnrollmentJWT = types.StringNull()
+	}
+	if detail.EnrollmentCreatedAt != nil {
+		state.EnrollmentCreatedAt = types.StringValue(detail.EnrollmentCreatedAt.String())
+	} else {
+		state.EnrollmentCreatedAt = types.StringNull()
+	}
+	if detail.EnrollmentExpiresAt != nil {
+		state.EnrollmentExpiresAt = types.StringValue(detail.EnrollmentExpiresAt.String())
+	} else {
+		state.EnrollmentExpiresAt = types.StringNull()
+	}
+
+	if detail.Fingerprint != "" {
+		state.Fingerprint = types.StringValue(detail.Fingerprint)
+	} else {
+		state.Fingerprint = types.StringNull()
+	}
+	state.Hostname = types.StringPointerValue(detail.Hostname)
+	state.IsOnline = types.BoolPointerValue(detail.IsOnline)
+	state.IsVerified = types.BoolPointerValue(detail.IsVerified)
+	state.SyncStatus = types.StringPointerValue(detail.SyncStatus)
+}
+
+func (r *ZitiEdgeRouterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiEdgeRouterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleAttributes rest_model.Attributes = ElementsToListOfStrings(plan.RoleAttributes.Elements())
+
+	appData := TagsFromAttributes(plan.AppData.Elements())
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	cost := plan.Cost.ValueInt64()
+	noTraversal := plan.NoTraversal.ValueBool()
+	disabled := plan.Disabled.ValueBool()
+
+	edgeRouterCreate := rest_model.EdgeRouterCreate{
+		AppData:           appData,
+		Cost:              &cost,
+		Disabled:          &disabled,
+		IsTunnelerEnabled: plan.IsTunnelerEnabled.ValueBool(),
+		Name:              &name,
+		NoTraversal:       &noTraversal,
+		RoleAttributes:    &roleAttributes,
+		Tags:              tags,
+	}
+
+	params := edge_router.NewCreateEdgeRouterParams()
+	params.EdgeRouter = &edgeRouterCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateEdgeRouter req")
+
+	data, err := r.client.API.EdgeRouter.CreateEdgeRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Edge Router from API",
+			"Could not create Ziti Edge Router "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// The enrollment and status values are only known once the router is created,
+	// hence an additional detail request.
+	detailParams := edge_router.NewDetailEdgeRouterParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.EdgeRouter.DetailEdgeRouter(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Edge Router from API",
+			"Could not read Ziti Edge Router ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		// Keep the created router in the state so it is not orphaned.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	plan.SetComputedFromDetail(detail.Payload.Data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEdgeRouterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiEdgeRouterResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Edge Router")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := edge_router.NewDetailEdgeRouterParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.EdgeRouter.DetailEdgeRouter(params, nil)
+	if _, ok := err.(*edge_router.DetailEdgeRouterNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Edge Router from API",
+			"Could not read Ziti Edge Router ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	edgeRouter := data.Payload.Data
+
+	state.Name = types.StringValue(*edgeRouter.Name)
+
+	if edgeRouter.RoleAttributes != nil && len(*edgeRouter.RoleAttributes) > 0 {
+		roleAttributes, diag := types.ListValueFrom(ctx, types.StringType, *edgeRouter.RoleAttributes)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.RoleAttributes = roleAttributes
+	} else {
+		state.RoleAttributes = types.ListNull(types.StringType)
+	}
+
+	state.Cost = types.Int64PointerValue(edgeRouter.Cost)
+	state.NoTraversal = types.BoolPointerValue(edgeRouter.NoTraversal)
+	state.Disabled = types.BoolPointerValue(edgeRouter.Disabled)
+	state.IsTunnelerEnabled = types.BoolPointerValue(edgeRouter.IsTunnelerEnabled)
+
+	if edgeRouter.AppData != nil && len(edgeRouter.AppData.SubTags) != 0 {
+		appData, diag := types.MapValueFrom(ctx, types.StringType, edgeRouter.AppData.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.AppData = appData
+	} else {
+		state.AppData = types.MapNull(types.StringType)
+	}
+
+	if len(edgeRouter.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, edgeRouter.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	state.SetComputedFromDetail(edgeRouter)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiEdgeRouterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiEdgeRouterResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Edge Router")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleAttributes rest_model.Attributes = ElementsToListOfStrings(plan.RoleAttributes.Elements())
+
+	appData := TagsFromAttributes(plan.AppData.Elements())
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	cost := plan.Cost.ValueInt64()
+	noTraversal := plan.NoTraversal.ValueBool()
+	disabled := plan.Disabled.ValueBool()
+
+	edgeRouterUpdate := rest_model.EdgeRouterUpdate{
+		AppData:           appData,
+		Cost:              &cost,
+		Disabled:          &disabled,
+		IsTunnelerEnabled: plan.IsTunnelerEnabled.ValueBool(),
+		Name:              &name,
+		NoTraversal:       &noTraversal,
+		RoleAttributes:    &roleAttributes,
+		Tags:              tags,
+	}
+
+	params := edge_router.NewUpdateEdgeRouterParams()
+	params.ID = plan.ID.ValueString()
+	params.EdgeRouter = &edgeRouterUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateEdgeRouter req")
+
+	_, err := r.client.API.EdgeRouter.UpdateEdgeRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Edge Router from API",
+			"Could not update Ziti Edge Router "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	detailParams := edge_router.NewDetailEdgeRouterParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.EdgeRouter.DetailEdgeRouter(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Edge Router from API",
+			"Could not read Ziti Edge Router ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.SetComputedFromDetail(detail.Payload.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEdgeRouterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiEdgeRouterResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Edge Router")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := edge_router.NewDeleteEdgeRouterParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.EdgeRouter.DeleteEdgeRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Edge Router from API",
+			"Could not delete Ziti Edge Router "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiEdgeRouterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
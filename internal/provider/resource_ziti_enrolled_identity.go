@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/identity"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+	"github.com/openziti/sdk-golang/ziti"
+	"github.com/openziti/sdk-golang/ziti/enroll"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiEnrolledIdentityResource{}
+
+func NewZitiEnrolledIdentityResource() resource.Resource {
+	return &ZitiEnrolledIdentityResource{}
+}
+
+// ZitiEnrolledIdentityResource defines the resource implementation.
+type ZitiEnrolledIdentityResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiEnrolledIdentityResourceModel describes the resource data model.
+type ZitiEnrolledIdentityResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	AuthPolicyID   types.String `tfsdk:"auth_policy_id"`
+	IsAdmin        types.Bool   `tfsdk:"is_admin"`
+	RoleAttributes types.List   `tfsdk:"role_attributes"`
+	Tags           types.Map    `tfsdk:"tags"`
+	Type           types.String `tfsdk:"type"`
+	KeyAlg         types.String `tfsdk:"key_alg"`
+
+	IdentityJSON types.String `tfsdk:"identity_json"`
+
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *ZitiEnrolledIdentityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enrolled_identity"
+}
+
+func (r *ZitiEnrolledIdentityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an identity of Ziti, which is enrolled by the provider with a one-time token(ott). A key pair is generated locally and the resulting identity file is exposed in identity_json. It cannot be imported, since the private key of an enrolled identity is never returned by the controller",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the identity",
+				Required:            true,
+			},
+			"auth_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Auth policy id",
+				Optional:            true,
+				Default:             stringdefault.StaticString("default"),
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether an identity is going to have admin rights in the Edge Management API(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"role_attributes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of role attributes",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the identity",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the identity.",
+				Default:             stringdefault.StaticString("Default"),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("User", "Device", "Service", "Router", "Default"),
+				},
+			},
+			"key_alg": schema.StringAttribute{
+				MarkdownDescription: "An algorithm of the key pair generated for the identity: EC or RSA(default EC). Changing it forces a new resource to be created",
+				Default:             stringdefault.StaticString("EC"),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("EC", "RSA"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_json": schema.StringAttribute{
+				MarkdownDescription: "An identity file of the enrolled identity(ztAPI, id.cert, id.key, id.ca), ready to be used by the Ziti SDKs and tunnelers",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiEnrolledIdentityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// EnrollIdentity completes an ott enrollment against the controller which issued the jwt, using
+// a key pair generated locally, and returns the resulting identity file.
+func EnrollIdentity(jwt string, keyAlg string) (string, error) {
+	claims, jwtToken, err := enroll.ParseToken(jwt)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the enrollment JWT: %w", err)
+	}
+
+	flags := enroll.EnrollmentFlags{
+		Token:     claims,
+		JwtToken:  jwtToken,
+		JwtString: jwt,
+		KeyAlg:    ziti.KeyAlgVar(keyAlg),
+	}
+	config, err := enroll.Enroll(flags)
+	if err != nil {
+		return "", err
+	}
+
+	identityJson, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the identity file: %w", err)
+	}
+	return string(identityJson), nil
+}
+
+func (r *ZitiEnrolledIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiEnrolledIdentityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleAttributes rest_model.Attributes
+	for _, value := range plan.RoleAttributes.Elements() {
+		if roleAttribute, ok := value.(types.String); ok {
+			roleAttributes = append(roleAttributes, roleAttribute.ValueString())
+		}
+	}
+
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	authPolicyId := plan.AuthPolicyID.ValueString()
+	isAdmin := plan.IsAdmin.ValueBool()
+	type_ := rest_model.IdentityType(plan.Type.ValueString())
+	identityCreate := rest_model.IdentityCreate{
+		AuthPolicyID:   &authPolicyId,
+		IsAdmin:        &isAdmin,
+		Name:           &name,
+		RoleAttributes: &roleAttributes,
+		Tags:           tags,
+		Type:           &type_,
+		Enrollment: &rest_model.IdentityCreateEnrollment{
+			Ott: true,
+		},
+	}
+
+	params := identity.NewCreateIdentityParams()
+	params.Identity = &identityCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateIdentity req")
+
+	data, err := r.client.API.Identity.CreateIdentity(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Identity from API",
+			"Could not create Ziti Identity "+name+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// The enrollment JWT is only returned by the detail of the identity.
+	detailParams := identity.NewDetailIdentityParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.Identity.DetailIdentity(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity from API",
+			"Could not read Ziti Identity "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	enrollments := detail.Payload.Data.Enrollment
+	if enrollments == nil || enrollments.Ott == nil || enrollments.Ott.JWT == "" {
+		resp.Diagnostics.AddError(
+			"Error Enrolling Ziti Identity",
+			"Ziti Identity "+plan.ID.ValueString()+" has no ott enrollment to complete",
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Enrolling Ziti Identity "+plan.ID.ValueString())
+
+	identityJson, err := EnrollIdentity(enrollments.Ott.JWT, plan.KeyAlg.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enrolling Ziti Identity",
+			"Could not enroll Ziti Identity "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+	plan.IdentityJSON = types.StringValue(identityJson)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEnrolledIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiEnrolledIdentityResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Identity")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := identity.NewDetailIdentityParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Identity.DetailIdentity(params, nil)
+	if _, ok := err.(*identity.DetailIdentityNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity from API",
+			"Could not read Ziti Identity ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringPointerValue(data.Payload.Data.Name)
+	state.AuthPolicyID = types.StringPointerValue(data.Payload.Data.AuthPolicyID)
+	state.IsAdmin = types.BoolPointerValue(data.Payload.Data.IsAdmin)
+
+	if data.Payload.Data.RoleAttributes != nil {
+		roleAttributes, diag := types.ListValueFrom(ctx, types.StringType, data.Payload.Data.RoleAttributes)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.RoleAttributes = roleAttributes
+	} else {
+		state.RoleAttributes = types.ListNull(types.StringType)
+	}
+
+	if len(data.Payload.Data.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, data.Payload.Data.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	state.Type = types.StringValue(data.Payload.Data.Type.Name)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiEnrolledIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiEnrolledIdentityResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Identity")
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleAttributes rest_model.Attributes
+	for _, value := range plan.RoleAttributes.Elements() {
+		if roleAttribute, ok := value.(types.String); ok {
+			roleAttributes = append(roleAttributes, roleAttribute.ValueString())
+		}
+	}
+
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	authPolicyId := plan.AuthPolicyID.ValueString()
+	isAdmin := plan.IsAdmin.ValueBool()
+	type_ := rest_model.IdentityType(plan.Type.ValueString())
+	// Patch only the managed fields, so that the rest of the identity is left as is.
+	identityPatch := rest_model.IdentityPatch{
+		AuthPolicyID:   &authPolicyId,
+		IsAdmin:        &isAdmin,
+		Name:           &name,
+		RoleAttributes: &roleAttributes,
+		Tags:           tags,
+		Type:           type_,
+	}
+
+	params := identity.NewPatchIdentityParams()
+	params.Identity = &identityPatch
+	params.ID = plan.ID.ValueString()
+
+	tflog.Debug(ctx, "Assigned all the params. Making PatchIdentity req")
+
+	_, err := r.client.API.Identity.PatchIdentity(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Identity from API",
+			"Could not update Ziti Identity "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEnrolledIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiEnrolledIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := identity.NewDeleteIdentityParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Identity.DeleteIdentity(params, nil)
+	if _, ok := err.(*identity.DeleteIdentityNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Identity from API",
+			"Could not delete Ziti Identity "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/enrollment"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiEnrollmentResource{}
+var _ resource.ResourceWithImportState = &ZitiEnrollmentResource{}
+var _ resource.ResourceWithConfigValidators = &ZitiEnrollmentResource{}
+var _ resource.ResourceWithValidateConfig = &ZitiEnrollmentResource{}
+var _ resource.ResourceWithModifyPlan = &ZitiEnrollmentResource{}
+
+func NewZitiEnrollmentResource() resource.Resource {
+	return &ZitiEnrollmentResource{}
+}
+
+// ZitiEnrollmentResource defines the resource implementation.
+type ZitiEnrollmentResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiEnrollmentResourceModel describes the resource data model.
+type ZitiEnrollmentResourceModel struct {
+	IdentityID    types.String `tfsdk:"identity_id"`
+	Method        types.String `tfsdk:"method"`
+	CaID          types.String `tfsdk:"ca_id"`
+	Username      types.String `tfsdk:"username"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Validity      types.String `tfsdk:"validity"`
+	RefreshBefore types.String `tfsdk:"refresh_before"`
+
+	JWT types.String `tfsdk:"jwt"`
+	ID  types.String `tfsdk:"id"`
+}
+
+func (r *ZitiEnrollmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enrollment"
+}
+
+func (r *ZitiEnrollmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an enrollment of an existing Ziti identity. The enrollment is refreshed once it gets close to its expiration. The controller removes an enrollment once it is consumed, which makes Terraform plan to create it again",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the enrollment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to enroll. Changing it forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "A method of the enrollment: ott, ottca or updb. Changing it forces a new resource to be created",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ott", "ottca", "updb"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the certificate authority which signs the identity certificate. Required for the ottca method",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "A username of the identity. Required for the updb method",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "An RFC3339 timestamp of when the enrollment expires. Changing it refreshes the enrollment. Computed from validity when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validity": schema.StringAttribute{
+				MarkdownDescription: "A duration the enrollment is valid for, used when expires_at is not set(default \"24h\"). Changing it refreshes the enrollment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("24h"),
+			},
+			"refresh_before": schema.StringAttribute{
+				MarkdownDescription: "A duration before the expiration, within which the enrollment is refreshed on apply. Only applies when expires_at is not set(default \"1h\")",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("1h"),
+			},
+			"jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the identity with",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiEnrollmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("expires_at"),
+			path.MatchRoot("validity"),
+		),
+	}
+}
+
+func (r *ZitiEnrollmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ZitiEnrollmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateEnrollmentMethod(path.Empty(), config.Method, config.CaID, config.Username)...)
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid Enrollment Expiration", "expires_at must be an RFC3339 timestamp: "+err.Error())
+		}
+	}
+	for name, value := range map[string]types.String{"validity": config.Validity, "refresh_before": config.RefreshBefore} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.ParseDuration(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Enrollment Duration", name+" must be a duration(eg \"24h\"): "+err.Error())
+		}
+	}
+}
+
+// ModifyPlan plans a refresh of an enrollment whose expiration is computed from another validity, or from validity
+// rather than a configured expires_at, or is about to pass.
+func (r *ZitiEnrollmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan upon destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config ZitiEnrollmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validity is not used while expires_at is set, so it is not kept in the state then. This tells an expiration
+	// computed from validity from a configured one.
+	if !config.ExpiresAt.IsNull() && config.Validity.IsNull() {
+		plan.Validity = types.StringNull()
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("validity"), plan.Validity)...)
+	}
+
+	// Nothing to refresh upon create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ZitiEnrollmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ExpiresAt.IsNull() && !plan.Validity.Equal(state.Validity) {
+		tflog.Debug(ctx, "Planning a refresh of Ziti Enrollment "+state.ID.ValueString()+" for validity "+plan.Validity.String())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())...)
+		return
+	}
+
+	if plan.RefreshBefore.IsUnknown() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	if err != nil {
+		return
+	}
+	refreshBefore, err := time.ParseDuration(plan.RefreshBefore.ValueString())
+	if err != nil {
+		return
+	}
+	if time.Until(expiresAt) > refreshBefore {
+		return
+	}
+
+	if !config.ExpiresAt.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Ziti Enrollment is about to expire",
+			"The enrollment "+state.ID.ValueString()+" expires at "+state.ExpiresAt.ValueString()+". Change expires_at to refresh it",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Planning a refresh of Ziti Enrollment "+state.ID.ValueString()+" which expires at "+state.ExpiresAt.ValueString())
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())...)
+}
+
+func (r *ZitiEnrollmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// plannedExpiresAt returns the configured expiration, or the one computed from validity when it is unknown.
+func (plan *ZitiEnrollmentResourceModel) plannedExpiresAt() (time.Time, error) {
+	if !plan.ExpiresAt.IsUnknown() && !plan.ExpiresAt.IsNull() {
+		return time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+	}
+	validity, err := time.ParseDuration(plan.Validity.ValueString())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(validity).UTC().Truncate(time.Second), nil
+}
+
+// SetFromDetail sets the state from an enrollment detail. A configured expires_at is kept when it denotes the same instant.
+func (state *ZitiEnrollmentResourceModel) SetFromDetail(detail *rest_model.EnrollmentDetail) {
+	state.IdentityID = types.StringValue(detail.IdentityID)
+	state.Method = types.StringPointerValue(detail.Method)
+	state.CaID = stringOrNull(detail.CaID)
+	state.Username = stringOrNull(&detail.Username)
+	state.JWT = types.StringValue(detail.JWT)
+
+	if detail.ExpiresAt != nil {
+		expiresAt := time.Time(*detail.ExpiresAt)
+		previous, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+		if err != nil || !previous.Equal(expiresAt) {
+			state.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+		}
+	} else {
+		state.ExpiresAt = types.StringNull()
+	}
+}
+
+func (r *ZitiEnrollmentResource) readEnrollment(id string) (*rest_model.EnrollmentDetail, error) {
+	params := enrollment.NewDetailEnrollmentParams()
+	params.ID = id
+	data, err := r.client.API.Enrollment.DetailEnrollment(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return data.Payload.Data, nil
+}
+
+func (r *ZitiEnrollmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiEnrollmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAtTime, err := plan.plannedExpiresAt()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Enrollment",
+			"Could not compute the expiration of Ziti Enrollment: "+err.Error(),
+		)
+		return
+	}
+	expiresAt := strfmt.DateTime(expiresAtTime)
+	identityId := plan.IdentityID.ValueString()
+	method := plan.Method.ValueString()
+
+	enrollmentCreate := rest_model.EnrollmentCreate{
+		IdentityID: &identityId,
+		Method:     &method,
+		ExpiresAt:  &expiresAt,
+		CaID:       plan.CaID.ValueStringPointer(),
+		Username:   plan.Username.ValueStringPointer(),
+	}
+
+	params := enrollment.NewCreateEnrollmentParams()
+	params.Enrollment = &enrollmentCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateEnrollment req")
+
+	data, err := r.client.API.Enrollment.CreateEnrollment(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Enrollment from API",
+			"Could not create Ziti Enrollment for identity "+identityId+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+	if plan.ExpiresAt.IsUnknown() {
+		plan.ExpiresAt = types.StringValue(expiresAtTime.Format(time.RFC3339))
+	}
+
+	detail, err := r.readEnrollment(plan.ID.ValueString())
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Enrollment from API",
+			"Could not read Ziti Enrollment "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+	plan.SetFromDetail(detail)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEnrollmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiEnrollmentResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Enrollment")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detail, err := r.readEnrollment(state.ID.ValueString())
+	if _, ok := err.(*enrollment.DetailEnrollmentNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Enrollment from API",
+			"Could not read Ziti Enrollment ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.SetFromDetail(detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiEnrollmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ZitiEnrollmentResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Enrollment")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the expiration can be changed in place, which is done by refreshing the enrollment.
+	if plan.ExpiresAt.IsUnknown() || !plan.ExpiresAt.Equal(state.ExpiresAt) {
+		expiresAtTime, err := plan.plannedExpiresAt()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Refreshing Ziti Enrollment",
+				"Could not compute the expiration of Ziti Enrollment "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		expiresAt := strfmt.DateTime(expiresAtTime)
+
+		params := enrollment.NewRefreshEnrollmentParams()
+		params.ID = plan.ID.ValueString()
+		params.Refresh = &rest_model.EnrollmentRefresh{
+			ExpiresAt: &expiresAt,
+		}
+
+		tflog.Debug(ctx, "Assigned all the params. Making RefreshEnrollment req")
+
+		_, err = r.client.API.Enrollment.RefreshEnrollment(params, nil)
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			resp.Diagnostics.AddError(
+				"Error Refreshing Ziti Enrollment from API",
+				"Could not refresh Ziti Enrollment "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if plan.ExpiresAt.IsUnknown() {
+			plan.ExpiresAt = types.StringValue(expiresAtTime.Format(time.RFC3339))
+		}
+	}
+
+	detail, err := r.readEnrollment(plan.ID.ValueString())
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Enrollment from API",
+			"Could not read Ziti Enrollment "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.SetFromDetail(detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiEnrollmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiEnrollmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := enrollment.NewDeleteEnrollmentParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Enrollment.DeleteEnrollment(params, nil)
+	if _, ok := err.(*enrollment.DeleteEnrollmentNotFound); ok {
+		// The enrollment has already been consumed or cleaned up by the controller.
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Enrollment from API",
+			"Could not delete Ziti Enrollment "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiEnrollmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The defaults, so that importing an enrollment whose expiration is computed from validity does not refresh it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("validity"), types.StringValue("24h"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("refresh_before"), types.StringValue("1h"))...)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// modifyEnrollmentPlan plans an update of an enrollment from state to config. The plan is what Terraform proposes,
+// ie the configured values, the defaults and the state of the other computed attributes.
+func modifyEnrollmentPlan(t *testing.T, state ZitiEnrollmentResourceModel, config ZitiEnrollmentResourceModel) ZitiEnrollmentResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &ZitiEnrollmentResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	plan := config
+	plan.ID = state.ID
+	plan.JWT = state.JWT
+	if config.ExpiresAt.IsNull() {
+		plan.ExpiresAt = state.ExpiresAt
+	}
+	if config.Validity.IsNull() {
+		plan.Validity = types.StringValue("24h")
+	}
+	if config.RefreshBefore.IsNull() {
+		plan.RefreshBefore = types.StringValue("1h")
+	}
+
+	req := resource.ModifyPlanRequest{
+		State:  enrollmentState(t, schemaResp.Schema, state),
+		Plan:   tfsdk.Plan(enrollmentState(t, schemaResp.Schema, plan)),
+		Config: tfsdk.Config(enrollmentState(t, schemaResp.Schema, config)),
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var modified ZitiEnrollmentResourceModel
+	require.False(t, resp.Plan.Get(ctx, &modified).HasError())
+	return modified
+}
+
+func enrollmentState(t *testing.T, schema schema.Schema, model ZitiEnrollmentResourceModel) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, state.Set(context.Background(), &model).HasError())
+	return state
+}
+
+func TestZitiEnrollmentResourceModifyPlan(t *testing.T) {
+	expiresAt := time.Now().Add(12 * time.Hour).UTC().Format(time.RFC3339)
+	enrollment := func(expiresAt types.String, validity types.String, refreshBefore types.String) ZitiEnrollmentResourceModel {
+		return ZitiEnrollmentResourceModel{
+			IdentityID:    types.StringValue("identity-id"),
+			Method:        types.StringValue("ott"),
+			CaID:          types.StringNull(),
+			Username:      types.StringNull(),
+			ExpiresAt:     expiresAt,
+			Validity:      validity,
+			RefreshBefore: refreshBefore,
+			JWT:           types.StringNull(),
+			ID:            types.StringNull(),
+		}
+	}
+	computedFromValidity := enrollment(types.StringValue(expiresAt), types.StringValue("24h"), types.StringValue("1h"))
+	computedFromValidity.ID = types.StringValue("enrollment-id")
+	computedFromValidity.JWT = types.StringValue("jwt")
+	configuredExpiresAt := computedFromValidity
+	configuredExpiresAt.Validity = types.StringNull()
+
+	tests := []struct {
+		name      string
+		state     ZitiEnrollmentResourceModel
+		config    ZitiEnrollmentResourceModel
+		refreshed bool
+	}{
+		{
+			name:      "unchanged validity",
+			state:     computedFromValidity,
+			config:    enrollment(types.StringNull(), types.StringNull(), types.StringNull()),
+			refreshed: false,
+		},
+		{
+			name:      "changed validity",
+			state:     computedFromValidity,
+			config:    enrollment(types.StringNull(), types.StringValue("720h"), types.StringNull()),
+			refreshed: true,
+		},
+		{
+			name:      "unchanged expires_at",
+			state:     configuredExpiresAt,
+			config:    enrollment(types.StringValue(expiresAt), types.StringNull(), types.StringNull()),
+			refreshed: false,
+		},
+		{
+			name:      "removed expires_at",
+			state:     configuredExpiresAt,
+			config:    enrollment(types.StringNull(), types.StringNull(), types.StringNull()),
+			refreshed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := modifyEnrollmentPlan(t, test.state, test.config)
+			assert.Equal(t, test.refreshed, plan.ExpiresAt.IsUnknown())
+			assert.Equal(t, test.refreshed, plan.JWT.IsUnknown())
+			assert.Equal(t, test.config.ExpiresAt.IsNull(), !plan.Validity.IsNull())
+		})
+	}
+}
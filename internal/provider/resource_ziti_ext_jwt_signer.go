@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/external_jwt_signer"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiExtJwtSignerResource{}
+var _ resource.ResourceWithImportState = &ZitiExtJwtSignerResource{}
+var _ resource.ResourceWithConfigValidators = &ZitiExtJwtSignerResource{}
+
+func NewZitiExtJwtSignerResource() resource.Resource {
+	return &ZitiExtJwtSignerResource{}
+}
+
+// ZitiExtJwtSignerResource defines the resource implementation.
+type ZitiExtJwtSignerResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiExtJwtSignerResourceModel describes the resource data model.
+type ZitiExtJwtSignerResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name            types.String `tfsdk:"name"`
+	Issuer          types.String `tfsdk:"issuer"`
+	Audience        types.String `tfsdk:"audience"`
+	JwksEndpoint    types.String `tfsdk:"jwks_endpoint"`
+	CertPem         types.String `tfsdk:"cert_pem"`
+	Kid             types.String `tfsdk:"kid"`
+	ClaimsProperty  types.String `tfsdk:"claims_property"`
+	UseExternalID   types.Bool   `tfsdk:"use_external_id"`
+	ExternalAuthURL types.String `tfsdk:"external_auth_url"`
+	ClientID        types.String `tfsdk:"client_id"`
+	Scopes          types.List   `tfsdk:"scopes"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Tags            types.Map    `tfsdk:"tags"`
+
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	CommonName  types.String `tfsdk:"common_name"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+}
+
+func (r *ZitiExtJwtSignerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ext_jwt_signer"
+}
+
+func (r *ZitiExtJwtSignerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("jwks_endpoint"),
+			path.MatchRoot("cert_pem"),
+		),
+	}
+}
+
+func (r *ZitiExtJwtSignerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define an external JWT signer of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the external JWT signer",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the external JWT signer",
+				Required:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "An expected value of the `iss` claim of the JWTs",
+				Required:            true,
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "An expected value of the `aud` claim of the JWTs",
+				Required:            true,
+			},
+			"jwks_endpoint": schema.StringAttribute{
+				MarkdownDescription: "A URL of the JWKS endpoint to fetch signing keys from. Conflicts with `cert_pem`",
+				Optional:            true,
+			},
+			"cert_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded certificate the JWTs are signed with. Conflicts with `jwks_endpoint`",
+				Optional:            true,
+			},
+			"kid": schema.StringAttribute{
+				MarkdownDescription: "A key id of the certificate set in `cert_pem`",
+				Optional:            true,
+			},
+			"claims_property": schema.StringAttribute{
+				MarkdownDescription: "A JWT claim to match against identities(default \"sub\")",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sub"),
+			},
+			"use_external_id": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether `claims_property` is matched against the `external_id` of identities instead of their ids(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"external_auth_url": schema.StringAttribute{
+				MarkdownDescription: "A URL clients are directed to in order to obtain a JWT",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "An OIDC client id clients use to obtain a JWT",
+				Optional:            true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A list of OIDC scopes clients request when obtaining a JWT",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the external JWT signer is enabled(default true)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the external JWT signer",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the certificate set in `cert_pem`",
+				Computed:            true,
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "A common name of the certificate set in `cert_pem`",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "A timestamp the certificate set in `cert_pem` is valid from",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "A timestamp the certificate set in `cert_pem` is valid until",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ZitiExtJwtSignerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiExtJwtSignerResourceModel) ToExternalJWTSignerCreate() rest_model.ExternalJWTSignerCreate {
+	var jwksEndpoint *strfmt.URI
+	if r.JwksEndpoint.ValueString() != "" {
+		uri := strfmt.URI(r.JwksEndpoint.ValueString())
+		jwksEndpoint = &uri
+	}
+
+	return rest_model.ExternalJWTSignerCreate{
+		Name:            r.Name.ValueStringPointer(),
+		Issuer:          r.Issuer.ValueStringPointer(),
+		Audience:        r.Audience.ValueStringPointer(),
+		JwksEndpoint:    jwksEndpoint,
+		CertPem:         r.CertPem.ValueStringPointer(),
+		Kid:             r.Kid.ValueStringPointer(),
+		ClaimsProperty:  r.ClaimsProperty.ValueStringPointer(),
+		UseExternalID:   r.UseExternalID.ValueBoolPointer(),
+		ExternalAuthURL: r.ExternalAuthURL.ValueStringPointer(),
+		ClientID:        r.ClientID.ValueStringPointer(),
+		Scopes:          ElementsToListOfStrings(r.Scopes.Elements()),
+		Enabled:         r.Enabled.ValueBoolPointer(),
+		Tags:            TagsFromAttributes(r.Tags.Elements()),
+	}
+}
+
+func stringOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func (state *ZitiExtJwtSignerResourceModel) SetFromDetail(ctx context.Context, detail *rest_model.ExternalJWTSignerDetail) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Name = types.StringPointerValue(detail.Name)
+	state.Issuer = types.StringPointerValue(detail.Issuer)
+	state.Audience = types.StringPointerValue(detail.Audience)
+
+	if detail.JwksEndpoint != nil && *detail.JwksEndpoint != "" {
+		state.JwksEndpoint = types.StringValue(detail.JwksEndpoint.String())
+	} else {
+		state.JwksEndpoint = types.StringNull()
+	}
+
+	// The controller may normalize the trailing whitespace of the PEM, keep the configured value in that case.
+	certPem := stringOrNull(detail.CertPem)
+	if certPem.IsNull() || strings.TrimSpace(certPem.ValueString()) != strings.TrimSpace(state.CertPem.ValueString()) {
+		state.CertPem = certPem
+	}
+
+	state.Kid = stringOrNull(detail.Kid)
+	state.ClaimsProperty = types.StringPointerValue(detail.ClaimsProperty)
+	state.UseExternalID = types.BoolPointerValue(detail.UseExternalID)
+	state.ExternalAuthURL = stringOrNull(detail.ExternalAuthURL)
+	state.ClientID = stringOrNull(detail.ClientID)
+
+	scopes, d := NativeListToTerraformTypedList(ctx, types.StringType, detail.Scopes)
+	diags.Append(d...)
+	state.Scopes = scopes
+
+	state.Enabled = types.BoolPointerValue(detail.Enabled)
+
+	if len(detail.BaseEntity.Tags.SubTags) != 0 {
+		tags, d := types.MapValueFrom(ctx, types.StringType, detail.BaseEntity.Tags.SubTags)
+		diags.Append(d...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	state.Fingerprint = stringOrNull(detail.Fingerprint)
+	state.CommonName = stringOrNull(detail.CommonName)
+	if detail.NotBefore != nil {
+		state.NotBefore = types.StringValue(detail.NotBefore.String())
+	} else {
+		state.NotBefore = types.StringNull()
+	}
+	if detail.NotAfter != nil {
+		state.NotAfter = types.StringValue(detail.NotAfter.String())
+	} else {
+		state.NotAfter = types.StringNull()
+	}
+
+	return diags
+}
+
+func (r *ZitiExtJwtSignerResource) readExtJwtSigner(ctx context.Context, state *ZitiExtJwtSignerResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := external_jwt_signer.NewDetailExternalJWTSignerParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.ExternalJWTSigner.DetailExternalJWTSigner(params, nil)
+	if _, ok := err.(*external_jwt_signer.DetailExternalJWTSignerNotFound); ok {
+		return false, diags
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		diags.AddError(
+			"Error Reading Ziti External JWT Signer from API",
+			"Could not read Ziti External JWT Signer ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return true, diags
+	}
+
+	diags.Append(state.SetFromDetail(ctx, data.Payload.Data)...)
+	return true, diags
+}
+
+func (r *ZitiExtJwtSignerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiExtJwtSignerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalJWTSignerCreate := plan.ToExternalJWTSignerCreate()
+
+	params := external_jwt_signer.NewCreateExternalJWTSignerParams()
+	params.ExternalJWTSigner = &externalJWTSignerCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateExternalJWTSigner req")
+
+	data, err := r.client.API.ExternalJWTSigner.CreateExternalJWTSigner(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti External JWT Signer from API",
+			"Could not create Ziti External JWT Signer "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// The certificate details are only known once the signer is created,
+	// hence an additional detail request.
+	_, diags := r.readExtJwtSigner(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// Keep the created signer in the state so it is not orphaned.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiExtJwtSignerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiExtJwtSignerResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti External JWT Signer")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readExtJwtSigner(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiExtJwtSignerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiExtJwtSignerResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti External JWT Signer")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalJWTSignerCreate := plan.ToExternalJWTSignerCreate()
+	externalJWTSignerUpdate := rest_model.ExternalJWTSignerUpdate{
+		Name:            externalJWTSignerCreate.Name,
+		Issuer:          externalJWTSignerCreate.Issuer,
+		Audience:        externalJWTSignerCreate.Audience,
+		JwksEndpoint:    externalJWTSignerCreate.JwksEndpoint,
+		CertPem:         externalJWTSignerCreate.CertPem,
+		Kid:             externalJWTSignerCreate.Kid,
+		ClaimsProperty:  externalJWTSignerCreate.ClaimsProperty,
+		UseExternalID:   externalJWTSignerCreate.UseExternalID,
+		ExternalAuthURL: externalJWTSignerCreate.ExternalAuthURL,
+		ClientID:        externalJWTSignerCreate.ClientID,
+		Scopes:          externalJWTSignerCreate.Scopes,
+		Enabled:         externalJWTSignerCreate.Enabled,
+		Tags:            externalJWTSignerCreate.Tags,
+	}
+
+	params := external_jwt_signer.NewUpdateExternalJWTSignerParams()
+	params.ID = plan.ID.ValueString()
+	params.ExternalJWTSigner = &externalJWTSignerUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateExternalJWTSigner req")
+
+	_, err := r.client.API.ExternalJWTSigner.UpdateExternalJWTSigner(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti External JWT Signer from API",
+			"Could not update Ziti External JWT Signer "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	_, diags := r.readExtJwtSigner(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiExtJwtSignerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiExtJwtSignerResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti External JWT Signer")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := external_jwt_signer.NewDeleteExternalJWTSignerParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.ExternalJWTSigner.DeleteExternalJWTSigner(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti External JWT Signer from API",
+			"Could not delete Ziti External JWT Signer "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiExtJwtSignerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
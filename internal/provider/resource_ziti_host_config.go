@@ -161,268 +161,274 @@ func (r *ZitiHostConfigResource) Metadata(ctx context.Context, req resource.Meta
 }
 
 func (r *ZitiHostConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := hostConfigResourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Id of a config",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Name of a config",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["config_type_id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Id of the config type. Defaults to the id of the `host.v1` config type of the controller",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "A resource to define a host.v1 config of Ziti",
 
-		Attributes: map[string]schema.Attribute{
-			"address": schema.StringAttribute{
-				MarkdownDescription: "A target host config address towards which traffic would be relayed.",
-				Optional:            true,
+		Attributes: attributes,
+	}
+}
+
+// hostConfigResourceAttributes returns the attributes of a host.v1 config, shared with the terminators of host.v2.
+func hostConfigResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			MarkdownDescription: "A target host config address towards which traffic would be relayed.",
+			Optional:            true,
+		},
+		"port": schema.Int32Attribute{
+			MarkdownDescription: "A port of a target address towards which traffic would be relayed",
+			Optional:            true,
+			Validators: []validator.Int32{
+				int32validator.Between(1, 65535),
 			},
-			"port": schema.Int32Attribute{
-				MarkdownDescription: "A port of a target address towards which traffic would be relayed",
-				Optional:            true,
-				Validators: []validator.Int32{
-					int32validator.Between(1, 65535),
-				},
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "A protocol which config would be allowed to receive",
+			Validators: []validator.String{
+				stringvalidator.OneOf("tcp", "udp"),
 			},
-			"protocol": schema.StringAttribute{
-				MarkdownDescription: "A protocol which config would be allowed to receive",
-				Validators: []validator.String{
-					stringvalidator.OneOf("tcp", "udp"),
+			Optional: true,
+		},
+		"forward_protocol": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedProtocols",
+			Optional:            true,
+		},
+		"forward_port": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedPortRanges",
+			Optional:            true,
+		},
+		"forward_address": schema.BoolAttribute{
+			MarkdownDescription: "A flag which controls whether to forward allowedAddresses",
+			Optional:            true,
+		},
+		"allowed_addresses": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed addresses that could be forwarded.",
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+		},
+		"allowed_source_addresses": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed source addresses that could be forwarded.",
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+		},
+		"listen_options": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"bind_using_edge_identity": schema.BoolAttribute{
+					Optional: true,
+				},
+				"connect_timeout": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("5s"),
+				},
+				"cost": schema.Int32Attribute{
+					Optional: true,
+					Computed: true,
+					Default:  int32default.StaticInt32(0),
+					Validators: []validator.Int32{
+						int32validator.Between(0, 65535),
+					},
+				},
+				"max_connections": schema.Int32Attribute{
+					Optional: true,
+					Computed: true,
+					Default:  int32default.StaticInt32(65535),
+					Validators: []validator.Int32{
+						int32validator.Between(1, 65535),
+					},
+				},
+				"precedence": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("default"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("default", "required", "failed"),
+					},
 				},
-				Optional: true,
-			},
-			"forward_protocol": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedProtocols",
-				Optional:            true,
-			},
-			"forward_port": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedPortRanges",
-				Optional:            true,
-			},
-			"forward_address": schema.BoolAttribute{
-				MarkdownDescription: "A flag which controls whether to forward allowedAddresses",
-				Optional:            true,
-			},
-			"allowed_addresses": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed addresses that could be forwarded.",
-				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
-			},
-			"allowed_source_addresses": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed source addresses that could be forwarded.",
-				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
 			},
-			"listen_options": schema.SingleNestedAttribute{
-				Optional: true,
+		},
+		"http_checks": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"bind_using_edge_identity": schema.BoolAttribute{
-						Optional: true,
+					"url": schema.StringAttribute{
+						Required: true,
 					},
-					"connect_timeout": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
-						Default:  stringdefault.StaticString("5s"),
+					"method": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("GET", "PUT", "POST", "PATCH"),
+						},
 					},
-					"cost": schema.Int32Attribute{
+					"body": schema.StringAttribute{
 						Optional: true,
-						Computed: true,
-						Default:  int32default.StaticInt32(0),
-						Validators: []validator.Int32{
-							int32validator.Between(0, 65535),
-						},
 					},
-					"max_connections": schema.Int32Attribute{
+					"expect_status": schema.Int32Attribute{
 						Optional: true,
 						Computed: true,
-						Default:  int32default.StaticInt32(65535),
+						Default:  int32default.StaticInt32(200),
 						Validators: []validator.Int32{
-							int32validator.Between(1, 65535),
+							int32validator.Between(1, 1000),
 						},
 					},
-					"precedence": schema.StringAttribute{
+					"expect_in_body": schema.StringAttribute{
 						Optional: true,
-						Computed: true,
-						Default:  stringdefault.StaticString("default"),
-						Validators: []validator.String{
-							stringvalidator.OneOf("default", "required", "failed"),
-						},
 					},
-				},
-			},
-			"http_checks": schema.ListNestedAttribute{
-				Optional: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"url": schema.StringAttribute{
-							Required: true,
-						},
-						"method": schema.StringAttribute{
-							Required: true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("GET", "PUT", "POST", "PATCH"),
-							},
-						},
-						"body": schema.StringAttribute{
-							Optional: true,
-						},
-						"expect_status": schema.Int32Attribute{
-							Optional: true,
-							Computed: true,
-							Default:  int32default.StaticInt32(200),
-							Validators: []validator.Int32{
-								int32validator.Between(1, 1000),
-							},
-						},
-						"expect_in_body": schema.StringAttribute{
-							Optional: true,
-						},
-						"interval": schema.StringAttribute{
-							Required: true,
-						},
-						"timeout": schema.StringAttribute{
-							Required: true,
-						},
-						"actions": schema.ListNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"trigger": schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("pass", "fail", "change"),
-										},
-									},
-									"duration": schema.StringAttribute{
-										Required: true,
+					"interval": schema.StringAttribute{
+						Required: true,
+					},
+					"timeout": schema.StringAttribute{
+						Required: true,
+					},
+					"actions": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"trigger": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("pass", "fail", "change"),
 									},
-									"action": schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.Any(
-												stringvalidator.OneOf("mark unhealthy", "mark healthy", "send event"),
-												stringvalidator.RegexMatches(
-													regexp.MustCompile(`^(increase|decrease) cost (-?\d+)$`),
-													"must have a valid syntax(eg 'increase cost 100')",
-												),
+								},
+								"duration": schema.StringAttribute{
+									Required: true,
+								},
+								"action": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.Any(
+											stringvalidator.OneOf("mark unhealthy", "mark healthy", "send event"),
+											stringvalidator.RegexMatches(
+												regexp.MustCompile(`^(increase|decrease) cost (-?\d+)$`),
+												"must have a valid syntax(eg 'increase cost 100')",
 											),
-										},
-									},
-									"consecutive_events": schema.Int32Attribute{
-										Optional: true,
-										Computed: true,
-										Default:  int32default.StaticInt32(1),
+										),
 									},
 								},
+								"consecutive_events": schema.Int32Attribute{
+									Optional: true,
+									Computed: true,
+									Default:  int32default.StaticInt32(1),
+								},
 							},
-							MarkdownDescription: "An array of actions to take upon health check result.",
-							Required:            true,
 						},
+						MarkdownDescription: "An array of actions to take upon health check result.",
+						Required:            true,
 					},
 				},
 			},
-			"port_checks": schema.ListNestedAttribute{
-				Optional: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"address": schema.StringAttribute{
-							Required: true,
-						},
-						"interval": schema.StringAttribute{
-							Required: true,
-						},
-						"timeout": schema.StringAttribute{
-							Required: true,
-						},
-						"actions": schema.ListNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"trigger": schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("pass", "fail", "change"),
-										},
-									},
-									"duration": schema.StringAttribute{
-										Required: true,
+		},
+		"port_checks": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Required: true,
+					},
+					"interval": schema.StringAttribute{
+						Required: true,
+					},
+					"timeout": schema.StringAttribute{
+						Required: true,
+					},
+					"actions": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"trigger": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("pass", "fail", "change"),
 									},
-									"action": schema.StringAttribute{
-										Required: true,
-										Validators: []validator.String{
-											stringvalidator.Any(
-												stringvalidator.OneOf("mark unhealthy", "mark healthy", "send event"),
-												stringvalidator.RegexMatches(
-													regexp.MustCompile(`^(increase|decrease) cost (-?\d+)$`),
-													"must have a valid syntax(eg 'increase cost 100')",
-												),
+								},
+								"duration": schema.StringAttribute{
+									Required: true,
+								},
+								"action": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.Any(
+											stringvalidator.OneOf("mark unhealthy", "mark healthy", "send event"),
+											stringvalidator.RegexMatches(
+												regexp.MustCompile(`^(increase|decrease) cost (-?\d+)$`),
+												"must have a valid syntax(eg 'increase cost 100')",
 											),
-										},
-									},
-									"consecutive_events": schema.Int32Attribute{
-										Optional: true,
-										Computed: true,
-										Default:  int32default.StaticInt32(1),
+										),
 									},
 								},
+								"consecutive_events": schema.Int32Attribute{
+									Optional: true,
+									Computed: true,
+									Default:  int32default.StaticInt32(1),
+								},
 							},
-							MarkdownDescription: "An array of actions to take upon health check result.",
-							Required:            true,
 						},
+						MarkdownDescription: "An array of actions to take upon health check result.",
+						Required:            true,
 					},
 				},
 			},
-			"allowed_protocols": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "An array of allowed protocols that could be forwarded.",
-				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
-						stringvalidator.OneOf("tcp", "udp"),
-					),
-				},
+		},
+		"allowed_protocols": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "An array of allowed protocols that could be forwarded.",
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListNull(types.StringType)),
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(
+					stringvalidator.OneOf("tcp", "udp"),
+				),
 			},
-			"allowed_port_ranges": schema.ListNestedAttribute{
-				Default:  listdefault.StaticValue(types.ListNull(AllowedPortRangeModel)),
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"low": schema.Int32Attribute{
-							Required: true,
-							Validators: []validator.Int32{
-								int32validator.Between(1, 65535),
-							},
+		},
+		"allowed_port_ranges": schema.ListNestedAttribute{
+			Default:  listdefault.StaticValue(types.ListNull(AllowedPortRangeModel)),
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"low": schema.Int32Attribute{
+						Required: true,
+						Validators: []validator.Int32{
+							int32validator.Between(1, 65535),
 						},
-						"high": schema.Int32Attribute{
-							Required: true,
-							Validators: []validator.Int32{
-								int32validator.Between(1, 65535),
-							},
+					},
+					"high": schema.Int32Attribute{
+						Required: true,
+						Validators: []validator.Int32{
+							int32validator.Between(1, 65535),
 						},
 					},
 				},
-				MarkdownDescription: "An array of allowed ports that could be forwarded.",
-				Optional:            true,
-			},
-			"id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "Id of a config",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"name": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Name of a config",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"config_type_id": schema.StringAttribute{
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("NH5p4FpGR"),
-				MarkdownDescription: "configTypeId",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
+			MarkdownDescription: "An array of allowed ports that could be forwarded.",
+			Optional:            true,
 		},
 	}
 }
@@ -732,8 +738,20 @@ func (r *ZitiHostConfigResource) Create(ctx context.Context, req resource.Create
 	jsonObj, _ := json.Marshal(requestObject)
 	tflog.Debug(ctx, string(jsonObj))
 
-	name := plan.Name.ValueString()
 	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		configTypeId, err = GetConfigTypeIdByName(r.client, "host.v1")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the host.v1 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
 	configCreate := rest_model.ConfigCreate{
 		ConfigTypeID: &configTypeId,
 		Name:         &name,
@@ -821,7 +839,7 @@ func (r *ZitiHostConfigResource) Read(ctx context.Context, req resource.ReadRequ
 	newState.Name = types.StringValue(*name)
 
 	newState.ID = state.ID
-	newState.ConfigTypeId = state.ConfigTypeId
+	newState.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
 	state = newState
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
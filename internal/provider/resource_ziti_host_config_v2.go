@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiHostConfigV2Resource{}
+var _ resource.ResourceWithImportState = &ZitiHostConfigV2Resource{}
+
+func NewZitiHostConfigV2Resource() resource.Resource {
+	return &ZitiHostConfigV2Resource{}
+}
+
+// ZitiHostConfigV2Resource defines the resource implementation.
+type ZitiHostConfigV2Resource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// HostConfigTerminatorModel describes a single terminator of a host.v2 config, which is shaped like a host.v1 config.
+var HostConfigTerminatorModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"address":                  types.StringType,
+		"port":                     types.Int32Type,
+		"protocol":                 types.StringType,
+		"forward_protocol":         types.BoolType,
+		"forward_port":             types.BoolType,
+		"forward_address":          types.BoolType,
+		"allowed_protocols":        types.ListType{ElemType: types.StringType},
+		"allowed_addresses":        types.ListType{ElemType: types.StringType},
+		"allowed_source_addresses": types.ListType{ElemType: types.StringType},
+		"allowed_port_ranges":      types.ListType{ElemType: AllowedPortRangeModel},
+		"listen_options":           ListenOptionsModel,
+		"port_checks":              types.ListType{ElemType: PortCheckModel},
+		"http_checks":              types.ListType{ElemType: HTTPCheckModel},
+	},
+}
+
+type HostConfigTerminatorObject struct {
+	Address                types.String `tfsdk:"address"`
+	Port                   types.Int32  `tfsdk:"port"`
+	Protocol               types.String `tfsdk:"protocol"`
+	ForwardProtocol        types.Bool   `tfsdk:"forward_protocol"`
+	ForwardPort            types.Bool   `tfsdk:"forward_port"`
+	ForwardAddress         types.Bool   `tfsdk:"forward_address"`
+	AllowedProtocols       types.List   `tfsdk:"allowed_protocols"`
+	AllowedAddresses       types.List   `tfsdk:"allowed_addresses"`
+	AllowedSourceAddresses types.List   `tfsdk:"allowed_source_addresses"`
+	AllowedPortRanges      types.List   `tfsdk:"allowed_port_ranges"`
+	ListenOptions          types.Object `tfsdk:"listen_options"`
+	PortChecks             types.List   `tfsdk:"port_checks"`
+	HTTPChecks             types.List   `tfsdk:"http_checks"`
+}
+
+// ZitiHostConfigV2ResourceModel describes the resource data model.
+type ZitiHostConfigV2ResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	ConfigTypeId types.String `tfsdk:"config_type_id"`
+	Terminators  types.List   `tfsdk:"terminators"`
+	ID           types.String `tfsdk:"id"`
+}
+
+type HostConfigV2DTO struct {
+	Terminators []HostConfigDTO `json:"terminators"`
+}
+
+// ToHostConfigDTO reuses the host.v1 conversion, since every terminator has the same shape as a host.v1 config.
+func (t *HostConfigTerminatorObject) ToHostConfigDTO(ctx context.Context) HostConfigDTO {
+	hostConfig := ZitiHostConfigResourceModel{
+		Address:                t.Address,
+		Port:                   t.Port,
+		Protocol:               t.Protocol,
+		ForwardProtocol:        t.ForwardProtocol,
+		ForwardPort:            t.ForwardPort,
+		ForwardAddress:         t.ForwardAddress,
+		AllowedProtocols:       t.AllowedProtocols,
+		AllowedAddresses:       t.AllowedAddresses,
+		AllowedSourceAddresses: t.AllowedSourceAddresses,
+		AllowedPortRanges:      t.AllowedPortRanges,
+		ListenOptions:          t.ListenOptions,
+		PortChecks:             t.PortChecks,
+		HTTPChecks:             t.HTTPChecks,
+	}
+	return hostConfig.ToHostConfigDTO(ctx)
+}
+
+func HostConfigDTOToTerminatorObject(ctx context.Context, dto HostConfigDTO) HostConfigTerminatorObject {
+	hostConfig := dto.ConvertToZitiResourceModel(ctx)
+	return HostConfigTerminatorObject{
+		Address:                hostConfig.Address,
+		Port:                   hostConfig.Port,
+		Protocol:               hostConfig.Protocol,
+		ForwardProtocol:        hostConfig.ForwardProtocol,
+		ForwardPort:            hostConfig.ForwardPort,
+		ForwardAddress:         hostConfig.ForwardAddress,
+		AllowedProtocols:       hostConfig.AllowedProtocols,
+		AllowedAddresses:       hostConfig.AllowedAddresses,
+		AllowedSourceAddresses: hostConfig.AllowedSourceAddresses,
+		AllowedPortRanges:      hostConfig.AllowedPortRanges,
+		ListenOptions:          hostConfig.ListenOptions,
+		PortChecks:             hostConfig.PortChecks,
+		HTTPChecks:             hostConfig.HTTPChecks,
+	}
+}
+
+func (dto *HostConfigV2DTO) ConvertToTerminatorsList(ctx context.Context) (types.List, diag.Diagnostics) {
+	terminators := []HostConfigTerminatorObject{}
+	for _, terminator := range dto.Terminators {
+		terminators = append(terminators, HostConfigDTOToTerminatorObject(ctx, terminator))
+	}
+	return types.ListValueFrom(ctx, HostConfigTerminatorModel, terminators)
+}
+
+func (r *ZitiHostConfigV2ResourceModel) ToHostConfigV2Data(ctx context.Context) (map[string]interface{}, diag.Diagnostics) {
+	var terminators []HostConfigTerminatorObject
+	diags := r.Terminators.ElementsAs(ctx, &terminators, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	terminatorsData := []map[string]interface{}{}
+	for _, terminator := range terminators {
+		terminatorData, err := JsonStructToObject(ctx, terminator.ToHostConfigDTO(ctx), true, true)
+		if err != nil {
+			diags.AddError(
+				"Error marshalling Ziti Config terminator",
+				"Could not convert a terminator of Ziti Config "+r.Name.ValueString()+": "+err.Error(),
+			)
+			return nil, diags
+		}
+		terminatorsData = append(terminatorsData, terminatorData)
+	}
+
+	return map[string]interface{}{
+		"terminators": terminatorsData,
+	}, diags
+}
+
+// hostConfigTerminatorAttributes returns the host.v1 attributes with the cross-attribute checks of ZitiHostConfigResource
+// expressed relative to each terminator, because resource level validators cannot address list elements.
+func hostConfigTerminatorAttributes() map[string]schema.Attribute {
+	attributes := hostConfigResourceAttributes()
+	sibling := func(name string) path.Expression {
+		return path.MatchRelative().AtParent().AtName(name)
+	}
+
+	address := attributes["address"].(schema.StringAttribute)
+	address.Validators = append(address.Validators,
+		stringvalidator.AtLeastOneOf(sibling("forward_address")),
+		stringvalidator.ConflictsWith(sibling("forward_address")),
+	)
+	attributes["address"] = address
+
+	protocol := attributes["protocol"].(schema.StringAttribute)
+	protocol.Validators = append(protocol.Validators,
+		stringvalidator.AtLeastOneOf(sibling("forward_protocol")),
+		stringvalidator.ConflictsWith(sibling("forward_protocol")),
+	)
+	attributes["protocol"] = protocol
+
+	port := attributes["port"].(schema.Int32Attribute)
+	port.Validators = append(port.Validators,
+		int32validator.AtLeastOneOf(sibling("forward_port")),
+		int32validator.ConflictsWith(sibling("forward_port")),
+	)
+	attributes["port"] = port
+
+	forwardProtocol := attributes["forward_protocol"].(schema.BoolAttribute)
+	forwardProtocol.Validators = append(forwardProtocol.Validators,
+		boolvalidator.AlsoRequires(sibling("allowed_protocols")),
+	)
+	attributes["forward_protocol"] = forwardProtocol
+
+	forwardPort := attributes["forward_port"].(schema.BoolAttribute)
+	forwardPort.Validators = append(forwardPort.Validators,
+		boolvalidator.AlsoRequires(sibling("allowed_port_ranges")),
+	)
+	attributes["forward_port"] = forwardPort
+
+	allowedProtocols := attributes["allowed_protocols"].(schema.ListAttribute)
+	allowedProtocols.Validators = append(allowedProtocols.Validators,
+		listvalidator.AlsoRequires(sibling("forward_protocol")),
+	)
+	attributes["allowed_protocols"] = allowedProtocols
+
+	allowedPortRanges := attributes["allowed_port_ranges"].(schema.ListNestedAttribute)
+	allowedPortRanges.Validators = append(allowedPortRanges.Validators,
+		listvalidator.AlsoRequires(sibling("forward_port")),
+	)
+	attributes["allowed_port_ranges"] = allowedPortRanges
+
+	return attributes
+}
+
+func (r *ZitiHostConfigV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_config_v2"
+}
+
+func (r *ZitiHostConfigV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a host.v2 config of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"terminators": schema.ListNestedAttribute{
+				MarkdownDescription: "An array of terminators to host, each shaped like a host.v1 config. Ordering together with listen_options cost and precedence allows to express active/standby backends.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: hostConfigTerminatorAttributes(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Id of the config type. Defaults to the id of the `host.v2` config type of the controller",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiHostConfigV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiHostConfigV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiHostConfigV2ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, diags := plan.ToHostConfigV2Data(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonObj, _ := json.Marshal(requestObject)
+	tflog.Debug(ctx, string(jsonObj))
+
+	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		var err error
+		configTypeId, err = GetConfigTypeIdByName(r.client, "host.v2")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the host.v2 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
+	configCreate := rest_model.ConfigCreate{
+		ConfigTypeID: &configTypeId,
+		Name:         &name,
+		Data:         requestObject,
+	}
+	params := config.NewCreateConfigParams()
+	params.Config = &configCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfig req")
+
+	data, err := r.client.API.Config.CreateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiHostConfigV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiHostConfigV2ResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfig(params, nil)
+	if _, ok := err.(*config.DetailConfigNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	responseData, ok := data.Payload.Data.Data.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error casting a response from a ziti controller to a dictionary",
+			"Could not cast a response from ziti to a dictionary",
+		)
+		return
+	}
+
+	var hostConfigDto HostConfigV2DTO
+	GenericFromObject(responseData, &hostConfigDto)
+
+	jsonObj, _ := json.Marshal(hostConfigDto)
+	tflog.Debug(ctx, "RESPONSE DETAIL")
+	tflog.Debug(ctx, string(jsonObj))
+
+	terminators, diags := hostConfigDto.ConvertToTerminatorsList(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Terminators = terminators
+	state.Name = types.StringPointerValue(data.Payload.Data.Name)
+	state.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiHostConfigV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiHostConfigV2ResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti config")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, diags := plan.ToHostConfigV2Data(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonObj, _ := json.Marshal(requestObject)
+	tflog.Debug(ctx, string(jsonObj))
+
+	name := plan.Name.ValueString()
+	configUpdate := rest_model.ConfigUpdate{
+		Name: &name,
+		Data: requestObject,
+	}
+
+	params := config.NewUpdateConfigParams()
+	params.ID = plan.ID.ValueString()
+	params.Config = &configUpdate
+
+	_, err := r.client.API.Config.UpdateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiHostConfigV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiHostConfigV2ResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config from API",
+			"Could not delete Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiHostConfigV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
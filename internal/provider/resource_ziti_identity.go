@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openziti/edge-api/rest_management_api_client/identity"
 	"github.com/openziti/edge-api/rest_model"
@@ -31,6 +35,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ZitiIdentityResource{}
 var _ resource.ResourceWithImportState = &ZitiIdentityResource{}
+var _ resource.ResourceWithValidateConfig = &ZitiIdentityResource{}
+var _ resource.ResourceWithModifyPlan = &ZitiIdentityResource{}
 
 func NewZitiIdentityResource() resource.Resource {
 	return &ZitiIdentityResource{}
@@ -41,6 +47,12 @@ type ZitiIdentityResource struct {
 	client *edge_apis.ManagementApiClient
 }
 
+type IdentityEnrollmentObject struct {
+	Method   types.String `tfsdk:"method"`
+	CaID     types.String `tfsdk:"ca_id"`
+	Username types.String `tfsdk:"username"`
+}
+
 // ZitiIdentityResourceModel describes the resource data model.
 type ZitiIdentityResourceModel struct {
 	Name                     types.String `tfsdk:"name"`
@@ -55,10 +67,136 @@ type ZitiIdentityResourceModel struct {
 	ServiceHostingPrecedence types.Map    `tfsdk:"service_hosting_precedence"`
 	Tags                     types.Map    `tfsdk:"tags"`
 	Type                     types.String `tfsdk:"type"`
+	Enrollment               types.Object `tfsdk:"enrollment"`
+
+	EnrollmentJWT       types.String `tfsdk:"enrollment_jwt"`
+	EnrollmentExpiresAt types.String `tfsdk:"enrollment_expires_at"`
+
+	Disabled                types.Bool   `tfsdk:"disabled"`
+	DisabledDurationMinutes types.Int64  `tfsdk:"disabled_duration_minutes"`
+	DisabledAt              types.String `tfsdk:"disabled_at"`
+	DisabledUntil           types.String `tfsdk:"disabled_until"`
 
 	ID types.String `tfsdk:"id"`
 }
 
+// ValidateEnrollmentMethod checks that ca_id and username are set only for the enrollment methods which use them.
+func ValidateEnrollmentMethod(base path.Path, method types.String, caId types.String, username types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if method.IsNull() || method.IsUnknown() {
+		return diags
+	}
+
+	requireCaId := method.ValueString() == "ottca"
+	requireUsername := method.ValueString() == "updb"
+
+	if requireCaId && caId.IsNull() {
+		diags.AddAttributeError(base.AtName("ca_id"), "Missing Enrollment CA", "ca_id is required when the enrollment method is ottca")
+	} else if !requireCaId && !caId.IsNull() {
+		diags.AddAttributeError(base.AtName("ca_id"), "Unexpected Enrollment CA", "ca_id can only be set when the enrollment method is ottca")
+	}
+	if requireUsername && username.IsNull() {
+		diags.AddAttributeError(base.AtName("username"), "Missing Enrollment Username", "username is required when the enrollment method is updb")
+	} else if !requireUsername && !username.IsNull() {
+		diags.AddAttributeError(base.AtName("username"), "Unexpected Enrollment Username", "username can only be set when the enrollment method is updb")
+	}
+	return diags
+}
+
+func (e *IdentityEnrollmentObject) ToIdentityCreateEnrollment() *rest_model.IdentityCreateEnrollment {
+	switch e.Method.ValueString() {
+	case "ott":
+		return &rest_model.IdentityCreateEnrollment{Ott: true}
+	case "ottca":
+		return &rest_model.IdentityCreateEnrollment{Ottca: e.CaID.ValueString()}
+	case "updb":
+		return &rest_model.IdentityCreateEnrollment{Updb: e.Username.ValueString()}
+	}
+	return nil
+}
+
+// SetEnrollmentFromDetail sets the JWT and the expiration of a pending enrollment. Both are null once the identity is enrolled.
+func (state *ZitiIdentityResourceModel) SetEnrollmentFromDetail(detail *rest_model.IdentityDetail) {
+	state.EnrollmentJWT = types.StringNull()
+	state.EnrollmentExpiresAt = types.StringNull()
+
+	enrollments := detail.Enrollment
+	if enrollments == nil {
+		return
+	}
+	if enrollments.Ott != nil {
+		state.EnrollmentJWT = types.StringValue(enrollments.Ott.JWT)
+		state.EnrollmentExpiresAt = types.StringValue(enrollments.Ott.ExpiresAt.String())
+	} else if enrollments.Ottca != nil {
+		state.EnrollmentJWT = types.StringValue(enrollments.Ottca.JWT)
+		state.EnrollmentExpiresAt = types.StringValue(enrollments.Ottca.ExpiresAt.String())
+	} else if enrollments.Updb != nil {
+		state.EnrollmentJWT = types.StringValue(enrollments.Updb.JWT)
+		state.EnrollmentExpiresAt = types.StringValue(enrollments.Updb.ExpiresAt.String())
+	}
+}
+
+// SetDisabledFromDetail sets whether the identity is disabled, and since and until when it is.
+// A timed disable which has expired is kept as is, since the controller enables such an identity back on its own,
+// and reporting it as enabled would disable it again on every apply.
+func (state *ZitiIdentityResourceModel) SetDisabledFromDetail(detail *rest_model.IdentityDetail) {
+	disabled := detail.Disabled != nil && *detail.Disabled
+	if !disabled && state.Disabled.ValueBool() && !state.DisabledDurationMinutes.IsNull() && state.disabledExpired() {
+		return
+	}
+
+	state.Disabled = types.BoolValue(disabled)
+	if detail.DisabledAt != nil {
+		state.DisabledAt = types.StringValue(detail.DisabledAt.String())
+	} else {
+		state.DisabledAt = types.StringNull()
+	}
+	if detail.DisabledUntil != nil {
+		state.DisabledUntil = types.StringValue(detail.DisabledUntil.String())
+	} else {
+		state.DisabledUntil = types.StringNull()
+	}
+}
+
+// disabledExpired tells whether the identity was disabled until a time which has passed.
+func (state *ZitiIdentityResourceModel) disabledExpired() bool {
+	if state.DisabledUntil.IsNull() || state.DisabledUntil.IsUnknown() {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, state.DisabledUntil.ValueString())
+	return err == nil && !until.After(time.Now())
+}
+
+// setDisabled disables the identity for durationMinutes(indefinitely if null), or enables it back.
+func (r *ZitiIdentityResource) setDisabled(id string, disabled bool, durationMinutes types.Int64) error {
+	if !disabled {
+		params := identity.NewEnableIdentityParams()
+		params.ID = id
+		_, err := r.client.API.Identity.EnableIdentity(params, nil)
+		return err
+	}
+
+	params := identity.NewDisableIdentityParams()
+	params.ID = id
+	params.Disable = &rest_model.DisableParams{
+		DurationMinutes: durationMinutes.ValueInt64Pointer(),
+	}
+	_, err := r.client.API.Identity.DisableIdentity(params, nil)
+	return err
+}
+
+// readDisabled fills the disabled_at and disabled_until of the identity after it has been disabled or enabled.
+func (r *ZitiIdentityResource) readDisabled(state *ZitiIdentityResourceModel) error {
+	params := identity.NewDetailIdentityParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Identity.DetailIdentity(params, nil)
+	if err != nil {
+		return err
+	}
+	state.SetDisabledFromDetail(data.Payload.Data)
+	return nil
+}
+
 func (r *ZitiIdentityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_identity"
 }
@@ -157,6 +295,72 @@ func (r *ZitiIdentityResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringvalidator.OneOf("User", "Device", "Service", "Router", "Default"),
 				},
 			},
+			"enrollment": schema.SingleNestedAttribute{
+				MarkdownDescription: "An enrollment to create along with the identity. Changing it forces a new resource to be created",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"method": schema.StringAttribute{
+						MarkdownDescription: "A method of the enrollment: ott, ottca or updb",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ott", "ottca", "updb"),
+						},
+					},
+					"ca_id": schema.StringAttribute{
+						MarkdownDescription: "Id of the certificate authority which signs the identity certificate. Required for the ottca method",
+						Optional:            true,
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "A username of the identity. Required for the updb method",
+						Optional:            true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"enrollment_jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the identity with. Empty once the identity is enrolled",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enrollment_expires_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment expires",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the identity is disabled, which prevents it from authenticating(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled_duration_minutes": schema.Int64Attribute{
+				MarkdownDescription: "A number of minutes the identity is disabled for. Can only be set when disabled is true. The identity is disabled indefinitely when it is not set. Changing it disables the identity again for the new duration. Once the duration has passed, the controller enables the identity back, while disabled stays true in the state, so that it is not disabled again until disabled or disabled_duration_minutes changes",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"disabled_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the identity was disabled",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disabled_until": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the identity is enabled again. Empty when the identity is disabled indefinitely",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -181,6 +385,54 @@ func (r *ZitiIdentityResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *ZitiIdentityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var disabled types.Bool
+	var disabledDurationMinutes types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disabled"), &disabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disabled_duration_minutes"), &disabledDurationMinutes)...)
+	if !disabledDurationMinutes.IsNull() && !disabled.IsUnknown() && !disabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disabled_duration_minutes"),
+			"Unexpected Disabled Duration",
+			"disabled_duration_minutes can only be set when disabled is true",
+		)
+	}
+
+	var enrollment types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enrollment"), &enrollment)...)
+	if resp.Diagnostics.HasError() || enrollment.IsNull() || enrollment.IsUnknown() {
+		return
+	}
+
+	var enrollmentObject IdentityEnrollmentObject
+	resp.Diagnostics.Append(enrollment.As(ctx, &enrollmentObject, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(ValidateEnrollmentMethod(path.Root("enrollment"), enrollmentObject.Method, enrollmentObject.CaID, enrollmentObject.Username)...)
+}
+
+// ModifyPlan plans new disabled_at and disabled_until whenever the identity gets disabled or enabled.
+func (r *ZitiIdentityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Create computes them anyway, and there is nothing to plan upon destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ZitiIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Disabled.Equal(state.Disabled) && plan.DisabledDurationMinutes.Equal(state.DisabledDurationMinutes) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("disabled_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("disabled_until"), types.StringUnknown())...)
+}
+
 func (r *ZitiIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ZitiIdentityResourceModel
 
@@ -237,6 +489,15 @@ func (r *ZitiIdentityResource) Create(ctx context.Context, req resource.CreateRe
 		Type:                      &type_,
 	}
 
+	if !plan.Enrollment.IsNull() {
+		var enrollment IdentityEnrollmentObject
+		resp.Diagnostics.Append(plan.Enrollment.As(ctx, &enrollment, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		identityCreate.Enrollment = enrollment.ToIdentityCreateEnrollment()
+	}
+
 	params := identity.NewCreateIdentityParams()
 	params.Identity = &identityCreate
 
@@ -256,6 +517,38 @@ func (r *ZitiIdentityResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	plan.ID = types.StringValue(data.Payload.Data.ID)
 
+	// The enrollment JWT is only returned by the detail of the identity.
+	detailParams := identity.NewDetailIdentityParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.Identity.DetailIdentity(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity from API",
+			"Could not read Ziti Identity "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+	plan.SetEnrollmentFromDetail(detail.Payload.Data)
+	plan.SetDisabledFromDetail(detail.Payload.Data)
+
+	if plan.Disabled.ValueBool() {
+		err = r.setDisabled(plan.ID.ValueString(), true, plan.DisabledDurationMinutes)
+		if err == nil {
+			err = r.readDisabled(&plan)
+		}
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			resp.Diagnostics.AddError(
+				"Error Disabling Ziti Identity from API",
+				"Could not disable Ziti Identity "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -344,6 +637,8 @@ func (r *ZitiIdentityResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	state.Type = types.StringValue(data.Payload.Data.Type.Name)
+	state.SetEnrollmentFromDetail(data.Payload.Data)
+	state.SetDisabledFromDetail(data.Payload.Data)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -353,12 +648,13 @@ func (r *ZitiIdentityResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *ZitiIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ZitiIdentityResourceModel
+	var plan, state ZitiIdentityResourceModel
 
 	tflog.Debug(ctx, "Updating Ziti Identity")
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -429,6 +725,22 @@ func (r *ZitiIdentityResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if !plan.Disabled.Equal(state.Disabled) || !plan.DisabledDurationMinutes.Equal(state.DisabledDurationMinutes) {
+		tflog.Debug(ctx, "Changing whether Ziti Identity "+plan.ID.ValueString()+" is disabled")
+		err = r.setDisabled(plan.ID.ValueString(), plan.Disabled.ValueBool(), plan.DisabledDurationMinutes)
+		if err == nil {
+			err = r.readDisabled(&plan)
+		}
+		if err != nil {
+			err = rest_util.WrapErr(err)
+			resp.Diagnostics.AddError(
+				"Error Updating Ziti Identity from API",
+				"Could not change whether Ziti Identity "+plan.ID.ValueString()+" is disabled: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
 }
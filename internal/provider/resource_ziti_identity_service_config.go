@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/identity"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiIdentityServiceConfigResource{}
+var _ resource.ResourceWithImportState = &ZitiIdentityServiceConfigResource{}
+
+func NewZitiIdentityServiceConfigResource() resource.Resource {
+	return &ZitiIdentityServiceConfigResource{}
+}
+
+// ZitiIdentityServiceConfigResource defines the resource implementation.
+type ZitiIdentityServiceConfigResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+var ServiceConfigModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"service_id": types.StringType,
+		"config_id":  types.StringType,
+	},
+}
+
+type ServiceConfigObject struct {
+	ServiceID types.String `tfsdk:"service_id"`
+	ConfigID  types.String `tfsdk:"config_id"`
+}
+
+// ZitiIdentityServiceConfigResourceModel describes the resource data model.
+type ZitiIdentityServiceConfigResourceModel struct {
+	IdentityID     types.String `tfsdk:"identity_id"`
+	ServiceConfigs types.List   `tfsdk:"service_configs"`
+	ID             types.String `tfsdk:"id"`
+}
+
+func (r *ZitiIdentityServiceConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_service_config"
+}
+
+func (r *ZitiIdentityServiceConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define which configs an identity gets for services, overriding the configs of the services. The overrides of the identity are managed authoritatively, so any override not defined here is removed",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the resource, which is the id of the identity",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity to override configs of. Changing it forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_configs": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of service and config pairs. The identity gets the config instead of the config of the same type defined on the service",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the service",
+							Required:            true,
+						},
+						"config_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the config",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiIdentityServiceConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ListIdentityServiceConfigs returns the service and config pairs overridden for the identity.
+func ListIdentityServiceConfigs(client *edge_apis.ManagementApiClient, identityId string) ([]ServiceConfigObject, error) {
+	params := identity.NewListIdentitysServiceConfigsParams()
+	params.ID = identityId
+	data, err := client.API.Identity.ListIdentitysServiceConfigs(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceConfigs := []ServiceConfigObject{}
+	for _, serviceConfig := range data.Payload.Data {
+		serviceConfigs = append(serviceConfigs, ServiceConfigObject{
+			ServiceID: types.StringPointerValue(serviceConfig.ServiceID),
+			ConfigID:  types.StringPointerValue(serviceConfig.ConfigID),
+		})
+	}
+	return serviceConfigs, nil
+}
+
+// serviceConfigsDifference returns the pairs of a, which are not in b.
+func serviceConfigsDifference(a []ServiceConfigObject, b []ServiceConfigObject) rest_model.ServiceConfigsAssignList {
+	var difference rest_model.ServiceConfigsAssignList
+	for _, x := range a {
+		found := false
+		for _, y := range b {
+			if x.ServiceID.Equal(y.ServiceID) && x.ConfigID.Equal(y.ConfigID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			difference = append(difference, &rest_model.ServiceConfigAssign{
+				ServiceID: x.ServiceID.ValueStringPointer(),
+				ConfigID:  x.ConfigID.ValueStringPointer(),
+			})
+		}
+	}
+	return difference
+}
+
+// sync makes the overrides of the identity exactly the planned ones.
+func (r *ZitiIdentityServiceConfigResource) sync(ctx context.Context, plan *ZitiIdentityServiceConfigResourceModel) error {
+	identityId := plan.IdentityID.ValueString()
+	var planned []ServiceConfigObject
+	if diags := plan.ServiceConfigs.ElementsAs(ctx, &planned, false); diags.HasError() {
+		return fmt.Errorf("failed to read the planned service configs")
+	}
+
+	existing, err := ListIdentityServiceConfigs(r.client, identityId)
+	if err != nil {
+		return err
+	}
+
+	if toRemove := serviceConfigsDifference(existing, planned); len(toRemove) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Removing %d service config overrides of Ziti Identity %s", len(toRemove), identityId))
+		params := identity.NewDisassociateIdentitysServiceConfigsParams()
+		params.ID = identityId
+		params.ServiceConfigIDPairs = toRemove
+		if _, err := r.client.API.Identity.DisassociateIdentitysServiceConfigs(params, nil); err != nil {
+			return err
+		}
+	}
+
+	if toAdd := serviceConfigsDifference(planned, existing); len(toAdd) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Adding %d service config overrides of Ziti Identity %s", len(toAdd), identityId))
+		params := identity.NewAssociateIdentitysServiceConfigsParams()
+		params.ID = identityId
+		params.ServiceConfigs = toAdd
+		if _, err := r.client.API.Identity.AssociateIdentitysServiceConfigs(params, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orderServiceConfigs orders the pairs read from the controller like the prior ones, so that their order does not show up as a change.
+func orderServiceConfigs(ctx context.Context, prior types.List, read []ServiceConfigObject) (types.List, diag.Diagnostics) {
+	var priorServiceConfigs []ServiceConfigObject
+	diags := prior.ElementsAs(ctx, &priorServiceConfigs, false)
+	if diags.HasError() {
+		return types.ListNull(ServiceConfigModel), diags
+	}
+
+	ordered := []ServiceConfigObject{}
+	for _, x := range priorServiceConfigs {
+		for _, y := range read {
+			if x.ServiceID.Equal(y.ServiceID) && x.ConfigID.Equal(y.ConfigID) {
+				ordered = append(ordered, y)
+				break
+			}
+		}
+	}
+	for _, y := range read {
+		found := false
+		for _, x := range ordered {
+			if x.ServiceID.Equal(y.ServiceID) && x.ConfigID.Equal(y.ConfigID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ordered = append(ordered, y)
+		}
+	}
+	return types.ListValueFrom(ctx, ServiceConfigModel, ordered)
+}
+
+func (r *ZitiIdentityServiceConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiIdentityServiceConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.sync(ctx, &plan)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Identity Service Configs from API",
+			"Could not set service configs of Ziti Identity "+plan.IdentityID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = plan.IdentityID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiIdentityServiceConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiIdentityServiceConfigResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Identity Service Configs")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The overrides are gone along with the identity.
+	params := identity.NewDetailIdentityParams()
+	params.ID = state.ID.ValueString()
+	_, err := r.client.API.Identity.DetailIdentity(params, nil)
+	if _, ok := err.(*identity.DetailIdentityNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity from API",
+			"Could not read Ziti Identity "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	serviceConfigs, err := ListIdentityServiceConfigs(r.client, state.ID.ValueString())
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Identity Service Configs from API",
+			"Could not read service configs of Ziti Identity "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	serviceConfigsList, diags := orderServiceConfigs(ctx, state.ServiceConfigs, serviceConfigs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ServiceConfigs = serviceConfigsList
+	state.IdentityID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiIdentityServiceConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiIdentityServiceConfigResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Identity Service Configs")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.sync(ctx, &plan)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Identity Service Configs from API",
+			"Could not set service configs of Ziti Identity "+plan.IdentityID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiIdentityServiceConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiIdentityServiceConfigResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Identity Service Configs")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ServiceConfigs = types.ListValueMust(ServiceConfigModel, []attr.Value{})
+	err := r.sync(ctx, &state)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Identity Service Configs from API",
+			"Could not remove service configs of Ziti Identity "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiIdentityServiceConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
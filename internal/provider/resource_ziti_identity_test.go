@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDisabledFromDetail(t *testing.T) {
+	disabledAt := strfmt.DateTime(time.Now().Add(-time.Hour))
+	past := strfmt.DateTime(time.Now().Add(-time.Minute))
+	future := strfmt.DateTime(time.Now().Add(time.Minute))
+
+	tests := []struct {
+		name     string
+		duration types.Int64
+		until    strfmt.DateTime
+		disabled bool
+	}{
+		{name: "expired timed disable", duration: types.Int64Value(59), until: past, disabled: true},
+		{name: "enabled before the end of a timed disable", duration: types.Int64Value(61), until: future, disabled: false},
+		{name: "enabled after an indefinite disable", duration: types.Int64Null(), until: past, disabled: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := ZitiIdentityResourceModel{
+				Disabled:                types.BoolValue(true),
+				DisabledDurationMinutes: test.duration,
+				DisabledAt:              types.StringValue(disabledAt.String()),
+				DisabledUntil:           types.StringValue(test.until.String()),
+			}
+			if test.duration.IsNull() {
+				state.DisabledUntil = types.StringNull()
+			}
+
+			enabled := false
+			state.SetDisabledFromDetail(&rest_model.IdentityDetail{Disabled: &enabled})
+
+			assert.Equal(t, test.disabled, state.Disabled.ValueBool())
+			assert.Equal(t, test.disabled, !state.DisabledAt.IsNull())
+		})
+	}
+}
@@ -148,8 +148,7 @@ func (r *ZitiInterceptConfigResource) Schema(ctx context.Context, req resource.S
 			"config_type_id": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString("g7cIWbcGg"),
-				MarkdownDescription: "configTypeId",
+				MarkdownDescription: "Id of the config type. Defaults to the id of the `intercept.v1` config type of the controller",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -281,8 +280,20 @@ func (r *ZitiInterceptConfigResource) Create(ctx context.Context, req resource.C
 	jsonObj, _ := json.Marshal(requestObject)
 	tflog.Debug(ctx, string(jsonObj))
 
-	name := plan.Name.ValueString()
 	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		configTypeId, err = GetConfigTypeIdByName(r.client, "intercept.v1")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the intercept.v1 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
 	configCreate := rest_model.ConfigCreate{
 		ConfigTypeID: &configTypeId,
 		Name:         &name,
@@ -370,7 +381,7 @@ func (r *ZitiInterceptConfigResource) Read(ctx context.Context, req resource.Rea
 	newState.Name = types.StringValue(*name)
 
 	newState.ID = state.ID
-	newState.ConfigTypeId = state.ConfigTypeId
+	newState.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
 	state = newState
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiProxyConfigResource{}
+var _ resource.ResourceWithImportState = &ZitiProxyConfigResource{}
+
+func NewZitiProxyConfigResource() resource.Resource {
+	return &ZitiProxyConfigResource{}
+}
+
+// ZitiProxyConfigResource defines the resource implementation.
+type ZitiProxyConfigResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiProxyConfigResourceModel describes the resource data model.
+type ZitiProxyConfigResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Port         types.Int32  `tfsdk:"port"`
+	Protocols    types.List   `tfsdk:"protocols"`
+	Binding      types.String `tfsdk:"binding"`
+	ConfigTypeId types.String `tfsdk:"config_type_id"`
+	ID           types.String `tfsdk:"id"`
+}
+
+func (r *ZitiProxyConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_config_v1"
+}
+
+func (r *ZitiProxyConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a proxy.v1 config of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "A port which a router proxy would listen on",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"protocols": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "An array of protocols which a router proxy would listen for.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("tcp", "udp"),
+					),
+				},
+			},
+			"binding": schema.StringAttribute{
+				MarkdownDescription: "An address of an interface which a router proxy would bind to",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Id of the config type. Defaults to the id of the `proxy.v1` config type of the controller",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiProxyConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type ProxyConfigDTO struct {
+	Port      *int32    `json:"port,omitempty"`
+	Protocols *[]string `json:"protocols,omitempty"`
+	Binding   *string   `json:"binding,omitempty"`
+}
+
+func (dto *ProxyConfigDTO) ConvertToZitiResourceModel(ctx context.Context) ZitiProxyConfigResourceModel {
+	return ZitiProxyConfigResourceModel{
+		Port:      types.Int32PointerValue(dto.Port),
+		Protocols: convertStringList(ctx, dto.Protocols, types.StringType),
+		Binding:   types.StringPointerValue(dto.Binding),
+	}
+}
+
+func (r *ZitiProxyConfigResourceModel) ToProxyConfigDTO(ctx context.Context) ProxyConfigDTO {
+	return ProxyConfigDTO{
+		Port:      r.Port.ValueInt32Pointer(),
+		Protocols: ElementsToStringArray(r.Protocols.Elements()),
+		Binding:   r.Binding.ValueStringPointer(),
+	}
+}
+
+func (r *ZitiProxyConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiProxyConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToProxyConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	jsonObj, _ := json.Marshal(requestObject)
+	tflog.Debug(ctx, string(jsonObj))
+
+	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		configTypeId, err = GetConfigTypeIdByName(r.client, "proxy.v1")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the proxy.v1 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
+	configCreate := rest_model.ConfigCreate{
+		ConfigTypeID: &configTypeId,
+		Name:         &name,
+		Data:         requestObject,
+	}
+	params := config.NewCreateConfigParams()
+	params.Config = &configCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfig req")
+
+	data, err := r.client.API.Config.CreateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiProxyConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiProxyConfigResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfig(params, nil)
+	if _, ok := err.(*config.DetailConfigNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	responseData, ok := data.Payload.Data.Data.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error casting a response from a ziti controller to a dictionary",
+			"Could not cast a response from ziti to a dictionary",
+		)
+		return
+	}
+
+	var proxyConfigDto ProxyConfigDTO
+	GenericFromObject(responseData, &proxyConfigDto)
+	newState := proxyConfigDto.ConvertToZitiResourceModel(ctx)
+
+	newState.Name = types.StringPointerValue(data.Payload.Data.Name)
+	newState.ID = state.ID
+	newState.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
+	state = newState
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiProxyConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiProxyConfigResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti config")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToProxyConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configUpdate := rest_model.ConfigUpdate{
+		Name: &name,
+		Data: requestObject,
+	}
+
+	params := config.NewUpdateConfigParams()
+	params.ID = plan.ID.ValueString()
+	params.Config = &configUpdate
+
+	_, err = r.client.API.Config.UpdateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiProxyConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiProxyConfigResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config from API",
+			"Could not delete Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiProxyConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
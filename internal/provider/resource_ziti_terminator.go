@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/terminator"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiTerminatorResource{}
+var _ resource.ResourceWithImportState = &ZitiTerminatorResource{}
+
+func NewZitiTerminatorResource() resource.Resource {
+	return &ZitiTerminatorResource{}
+}
+
+// ZitiTerminatorResource defines the resource implementation.
+type ZitiTerminatorResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTerminatorResourceModel describes the resource data model.
+type ZitiTerminatorResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	ServiceID      types.String `tfsdk:"service_id"`
+	RouterID       types.String `tfsdk:"router_id"`
+	Binding        types.String `tfsdk:"binding"`
+	Address        types.String `tfsdk:"address"`
+	Identity       types.String `tfsdk:"identity"`
+	IdentitySecret types.String `tfsdk:"identity_secret"`
+	Cost           types.Int64  `tfsdk:"cost"`
+	Precedence     types.String `tfsdk:"precedence"`
+	Tags           types.Map    `tfsdk:"tags"`
+}
+
+func (r *ZitiTerminatorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terminator"
+}
+
+func (r *ZitiTerminatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a terminator of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the terminator",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the service the terminator belongs to",
+				Required:            true,
+			},
+			"router_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the router which hosts the terminator",
+				Required:            true,
+			},
+			"binding": schema.StringAttribute{
+				MarkdownDescription: "A binding of the terminator, which determines how the router dials the address(default \"transport\")",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("transport"),
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "An address the router dials to reach the hosting server, e.g. `tcp:localhost:8080`",
+				Required:            true,
+			},
+			"identity": schema.StringAttribute{
+				MarkdownDescription: "An identity of the terminator, used to address a specific terminator of the service. Changing it forces a new resource to be created",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_secret": schema.StringAttribute{
+				MarkdownDescription: "A secret which proves the ownership of the terminator identity. Changing it forces a new resource to be created",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "A cost of the terminator. Defaults to 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"precedence": schema.StringAttribute{
+				MarkdownDescription: "A precedence of the terminator(default \"default\")",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "required", "failed"),
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the terminator",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+		},
+	}
+}
+
+func (r *ZitiTerminatorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZitiTerminatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiTerminatorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cost := rest_model.TerminatorCost(plan.Cost.ValueInt64())
+
+	terminatorCreate := rest_model.TerminatorCreate{
+		Service:    plan.ServiceID.ValueStringPointer(),
+		Router:     plan.RouterID.ValueStringPointer(),
+		Binding:    plan.Binding.ValueStringPointer(),
+		Address:    plan.Address.ValueStringPointer(),
+		Identity:   plan.Identity.ValueString(),
+		Cost:       &cost,
+		Precedence: rest_model.TerminatorPrecedence(plan.Precedence.ValueString()),
+		Tags:       TagsFromAttributes(plan.Tags.Elements()),
+	}
+	if plan.IdentitySecret.ValueString() != "" {
+		terminatorCreate.IdentitySecret = strfmt.Base64(plan.IdentitySecret.ValueString())
+	}
+
+	params := terminator.NewCreateTerminatorParams()
+	params.Terminator = &terminatorCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateTerminator req")
+
+	data, err := r.client.API.Terminator.CreateTerminator(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Terminator from API",
+			"Could not create Ziti Terminator "+plan.Address.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTerminatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiTerminatorResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Terminator")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := terminator.NewDetailTerminatorParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Terminator.DetailTerminator(params, nil)
+	if _, ok := err.(*terminator.DetailTerminatorNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Terminator from API",
+			"Could not read Ziti Terminator ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	terminatorDetail := data.Payload.Data
+
+	state.ServiceID = types.StringPointerValue(terminatorDetail.ServiceID)
+	state.RouterID = types.StringPointerValue(terminatorDetail.RouterID)
+	state.Binding = types.StringPointerValue(terminatorDetail.Binding)
+	state.Address = types.StringPointerValue(terminatorDetail.Address)
+	state.Identity = stringOrNull(terminatorDetail.Identity)
+
+	if terminatorDetail.Cost != nil {
+		state.Cost = types.Int64Value(int64(*terminatorDetail.Cost))
+	}
+	if terminatorDetail.Precedence != nil {
+		state.Precedence = types.StringValue(string(*terminatorDetail.Precedence))
+	}
+
+	if len(terminatorDetail.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, terminatorDetail.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiTerminatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiTerminatorResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Terminator")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cost := rest_model.TerminatorCost(plan.Cost.ValueInt64())
+
+	terminatorUpdate := rest_model.TerminatorUpdate{
+		Service:    plan.ServiceID.ValueStringPointer(),
+		Router:     plan.RouterID.ValueStringPointer(),
+		Binding:    plan.Binding.ValueStringPointer(),
+		Address:    plan.Address.ValueStringPointer(),
+		Cost:       &cost,
+		Precedence: rest_model.TerminatorPrecedence(plan.Precedence.ValueString()),
+		Tags:       TagsFromAttributes(plan.Tags.Elements()),
+	}
+
+	params := terminator.NewUpdateTerminatorParams()
+	params.ID = plan.ID.ValueString()
+	params.Terminator = &terminatorUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateTerminator req")
+
+	_, err := r.client.API.Terminator.UpdateTerminator(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Terminator from API",
+			"Could not update Ziti Terminator "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTerminatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiTerminatorResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Terminator")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := terminator.NewDeleteTerminatorParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.Terminator.DeleteTerminator(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Terminator from API",
+			"Could not delete Ziti Terminator "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiTerminatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
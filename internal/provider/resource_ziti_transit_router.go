@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/router"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiTransitRouterResource{}
+var _ resource.ResourceWithImportState = &ZitiTransitRouterResource{}
+
+func NewZitiTransitRouterResource() resource.Resource {
+	return &ZitiTransitRouterResource{}
+}
+
+// ZitiTransitRouterResource defines the resource implementation.
+type ZitiTransitRouterResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTransitRouterResourceModel describes the resource data model.
+type ZitiTransitRouterResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Name        types.String `tfsdk:"name"`
+	Cost        types.Int64  `tfsdk:"cost"`
+	NoTraversal types.Bool   `tfsdk:"no_traversal"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Tags        types.Map    `tfsdk:"tags"`
+
+	EnrollmentJWT       types.String `tfsdk:"enrollment_jwt"`
+	EnrollmentCreatedAt types.String `tfsdk:"enrollment_created_at"`
+	EnrollmentExpiresAt types.String `tfsdk:"enrollment_expires_at"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	IsOnline            types.Bool   `tfsdk:"is_online"`
+	IsVerified          types.Bool   `tfsdk:"is_verified"`
+}
+
+func (r *ZitiTransitRouterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_router"
+}
+
+func (r *ZitiTransitRouterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a transit router of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the transit router",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the transit router",
+				Required:            true,
+			},
+			"cost": schema.Int64Attribute{
+				MarkdownDescription: "A cost of routing traffic through the transit router. Defaults to 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"no_traversal": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the transit router is prevented from being used as a transit hop(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Controls whether the transit router is disabled(default false)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the transit router",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+			},
+
+			"enrollment_jwt": schema.StringAttribute{
+				MarkdownDescription: "A JWT to enroll the transit router with. Empty once the transit router is enrolled",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enrollment_created_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment was created",
+				Computed:            true,
+			},
+			"enrollment_expires_at": schema.StringAttribute{
+				MarkdownDescription: "A timestamp of when the enrollment expires",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the transit router certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router is currently connected to the controller",
+				Computed:            true,
+			},
+			"is_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transit router has completed enrollment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiTransitRouterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (state *ZitiTransitRouterResourceModel) SetComputedFromDetail(detail *rest_model.RouterDetail) {
+	if detail.EnrollmentJWT != nil {
+		state.EnrollmentJWT = types.StringValue(*detail.EnrollmentJWT)
+	} else {
+		state.EnrollmentJWT = types.StringNull()
+	}
+	if detail.EnrollmentCreatedAt != nil {
+		state.EnrollmentCreatedAt = types.StringValue(detail.EnrollmentCreatedAt.String())
+	} else {
+		state.EnrollmentCreatedAt = types.StringNull()
+	}
+	if detail.EnrollmentExpiresAt != nil {
+		state.EnrollmentExpiresAt = types.StringValue(detail.EnrollmentExpiresAt.String())
+	} else {
+		state.EnrollmentExpiresAt = types.StringNull()
+	}
+
+	state.Fingerprint = types.StringPointerValue(detail.Fingerprint)
+	state.IsOnline = types.BoolPointerValue(detail.IsOnline)
+	state.IsVerified = types.BoolPointerValue(detail.IsVerified)
+}
+
+func (r *ZitiTransitRouterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiTransitRouterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	cost := plan.Cost.ValueInt64()
+	noTraversal := plan.NoTraversal.ValueBool()
+	disabled := plan.Disabled.ValueBool()
+
+	routerCreate := rest_model.RouterCreate{
+		Cost:        &cost,
+		Disabled:    &disabled,
+		Name:        &name,
+		NoTraversal: &noTraversal,
+		Tags:        tags,
+	}
+
+	params := router.NewCreateTransitRouterParams()
+	params.Router = &routerCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateTransitRouter req")
+
+	data, err := r.client.API.Router.CreateTransitRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Transit Router from API",
+			"Could not create Ziti Transit Router "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// The enrollment and status values are only known once the router is created,
+	// hence an additional detail request.
+	detailParams := router.NewDetailTransitRouterParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.Router.DetailTransitRouter(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Transit Router from API",
+			"Could not read Ziti Transit Router ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		// Keep the created router in the state so it is not orphaned.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		return
+	}
+
+	plan.SetComputedFromDetail(detail.Payload.Data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTransitRouterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiTransitRouterResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti Transit Router")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := router.NewDetailTransitRouterParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Router.DetailTransitRouter(params, nil)
+	if _, ok := err.(*router.DetailTransitRouterNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Transit Router from API",
+			"Could not read Ziti Transit Router ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	transitRouter := data.Payload.Data
+
+	state.Name = types.StringValue(*transitRouter.Name)
+
+	state.Cost = types.Int64PointerValue(transitRouter.Cost)
+	state.NoTraversal = types.BoolPointerValue(transitRouter.NoTraversal)
+	state.Disabled = types.BoolPointerValue(transitRouter.Disabled)
+
+	if len(transitRouter.BaseEntity.Tags.SubTags) != 0 {
+		tags, diag := types.MapValueFrom(ctx, types.StringType, transitRouter.BaseEntity.Tags.SubTags)
+		resp.Diagnostics = append(resp.Diagnostics, diag...)
+		state.Tags = tags
+	} else {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	state.SetComputedFromDetail(transitRouter)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiTransitRouterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiTransitRouterResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti Transit Router")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := TagsFromAttributes(plan.Tags.Elements())
+
+	name := plan.Name.ValueString()
+	cost := plan.Cost.ValueInt64()
+	noTraversal := plan.NoTraversal.ValueBool()
+	disabled := plan.Disabled.ValueBool()
+
+	routerUpdate := rest_model.RouterUpdate{
+		Cost:        &cost,
+		Disabled:    &disabled,
+		Name:        &name,
+		NoTraversal: &noTraversal,
+		Tags:        tags,
+	}
+
+	params := router.NewUpdateTransitRouterParams()
+	params.ID = plan.ID.ValueString()
+	params.Router = &routerUpdate
+
+	tflog.Debug(ctx, "Assigned all the params. Making UpdateTransitRouter req")
+
+	_, err := r.client.API.Router.UpdateTransitRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Transit Router from API",
+			"Could not update Ziti Transit Router "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	detailParams := router.NewDetailTransitRouterParams()
+	detailParams.ID = plan.ID.ValueString()
+	detail, err := r.client.API.Router.DetailTransitRouter(detailParams, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Transit Router from API",
+			"Could not read Ziti Transit Router ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.SetComputedFromDetail(detail.Payload.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTransitRouterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan ZitiTransitRouterResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti Transit Router")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := router.NewDeleteTransitRouterParams()
+	params.ID = plan.ID.ValueString()
+
+	_, err := r.client.API.Router.DeleteTransitRouter(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Transit Router from API",
+			"Could not delete Ziti Transit Router "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiTransitRouterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiTunnelerClientConfigResource{}
+var _ resource.ResourceWithImportState = &ZitiTunnelerClientConfigResource{}
+
+func NewZitiTunnelerClientConfigResource() resource.Resource {
+	return &ZitiTunnelerClientConfigResource{}
+}
+
+// ZitiTunnelerClientConfigResource defines the resource implementation.
+type ZitiTunnelerClientConfigResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTunnelerClientConfigResourceModel describes the resource data model.
+type ZitiTunnelerClientConfigResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Port         types.Int32  `tfsdk:"port"`
+	ConfigTypeId types.String `tfsdk:"config_type_id"`
+	ID           types.String `tfsdk:"id"`
+}
+
+func (r *ZitiTunnelerClientConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tunneler_client_config_v1"
+}
+
+func (r *ZitiTunnelerClientConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a ziti-tunneler-client.v1 config of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "A hostname or an IP address which a tunneler would intercept.",
+				Required:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "A port which a tunneler would intercept",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Id of the config type. Defaults to the id of the `ziti-tunneler-client.v1` config type of the controller",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiTunnelerClientConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type TunnelerClientConfigDTO struct {
+	Hostname *string `json:"hostname,omitempty"`
+	Port     *int32  `json:"port,omitempty"`
+}
+
+func (dto *TunnelerClientConfigDTO) ConvertToZitiResourceModel(ctx context.Context) ZitiTunnelerClientConfigResourceModel {
+	return ZitiTunnelerClientConfigResourceModel{
+		Hostname: types.StringPointerValue(dto.Hostname),
+		Port:     types.Int32PointerValue(dto.Port),
+	}
+}
+
+func (r *ZitiTunnelerClientConfigResourceModel) ToTunnelerClientConfigDTO(ctx context.Context) TunnelerClientConfigDTO {
+	return TunnelerClientConfigDTO{
+		Hostname: r.Hostname.ValueStringPointer(),
+		Port:     r.Port.ValueInt32Pointer(),
+	}
+}
+
+func (r *ZitiTunnelerClientConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiTunnelerClientConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToTunnelerClientConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	jsonObj, _ := json.Marshal(requestObject)
+	tflog.Debug(ctx, string(jsonObj))
+
+	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		configTypeId, err = GetConfigTypeIdByName(r.client, "ziti-tunneler-client.v1")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the ziti-tunneler-client.v1 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
+	configCreate := rest_model.ConfigCreate{
+		ConfigTypeID: &configTypeId,
+		Name:         &name,
+		Data:         requestObject,
+	}
+	params := config.NewCreateConfigParams()
+	params.Config = &configCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfig req")
+
+	data, err := r.client.API.Config.CreateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTunnelerClientConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiTunnelerClientConfigResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfig(params, nil)
+	if _, ok := err.(*config.DetailConfigNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	responseData, ok := data.Payload.Data.Data.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error casting a response from a ziti controller to a dictionary",
+			"Could not cast a response from ziti to a dictionary",
+		)
+		return
+	}
+
+	var tunnelerClientConfigDto TunnelerClientConfigDTO
+	GenericFromObject(responseData, &tunnelerClientConfigDto)
+	newState := tunnelerClientConfigDto.ConvertToZitiResourceModel(ctx)
+
+	newState.Name = types.StringPointerValue(data.Payload.Data.Name)
+	newState.ID = state.ID
+	newState.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
+	state = newState
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiTunnelerClientConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiTunnelerClientConfigResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti config")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToTunnelerClientConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configUpdate := rest_model.ConfigUpdate{
+		Name: &name,
+		Data: requestObject,
+	}
+
+	params := config.NewUpdateConfigParams()
+	params.ID = plan.ID.ValueString()
+	params.Config = &configUpdate
+
+	_, err = r.client.API.Config.UpdateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTunnelerClientConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiTunnelerClientConfigResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config from API",
+			"Could not delete Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiTunnelerClientConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
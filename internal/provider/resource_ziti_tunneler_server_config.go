@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openziti/edge-api/rest_management_api_client/config"
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/edge-api/rest_util"
+	"github.com/openziti/sdk-golang/edge-apis"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZitiTunnelerServerConfigResource{}
+var _ resource.ResourceWithImportState = &ZitiTunnelerServerConfigResource{}
+
+func NewZitiTunnelerServerConfigResource() resource.Resource {
+	return &ZitiTunnelerServerConfigResource{}
+}
+
+// ZitiTunnelerServerConfigResource defines the resource implementation.
+type ZitiTunnelerServerConfigResource struct {
+	client *edge_apis.ManagementApiClient
+}
+
+// ZitiTunnelerServerConfigResourceModel describes the resource data model.
+var TunnelerServerListenOptionsModel = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"bind_using_edge_identity": types.BoolType,
+		"connect_timeout_seconds":  types.Int32Type,
+		"cost":                     types.Int32Type,
+		"identity":                 types.StringType,
+		"max_connections":          types.Int32Type,
+		"precedence":               types.StringType,
+	},
+}
+
+type ZitiTunnelerServerConfigResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Hostname      types.String `tfsdk:"hostname"`
+	Port          types.Int32  `tfsdk:"port"`
+	Protocol      types.String `tfsdk:"protocol"`
+	ListenOptions types.Object `tfsdk:"listen_options"`
+	PortChecks    types.List   `tfsdk:"port_checks"`
+	HTTPChecks    types.List   `tfsdk:"http_checks"`
+	ConfigTypeId  types.String `tfsdk:"config_type_id"`
+	ID            types.String `tfsdk:"id"`
+}
+
+func (r *ZitiTunnelerServerConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tunneler_server_config_v1"
+}
+
+func (r *ZitiTunnelerServerConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Health checks of ziti-tunneler-server.v1 are defined the same way as the ones of host.v1.
+	hostConfigAttributes := hostConfigResourceAttributes()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to define a ziti-tunneler-server.v1 config of Ziti",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "A target hostname or an IP address towards which traffic would be relayed.",
+				Required:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "A port of a target hostname towards which traffic would be relayed",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "A protocol which would be used to relay traffic to a target hostname",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp"),
+				},
+			},
+			"listen_options": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"bind_using_edge_identity": schema.BoolAttribute{
+						Optional: true,
+					},
+					"connect_timeout_seconds": schema.Int32Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int32default.StaticInt32(5),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"cost": schema.Int32Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.Between(0, 65535),
+						},
+					},
+					"identity": schema.StringAttribute{
+						Optional: true,
+					},
+					"max_connections": schema.Int32Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int32default.StaticInt32(65535),
+						Validators: []validator.Int32{
+							int32validator.Between(1, 65535),
+						},
+					},
+					"precedence": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("default"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("default", "required", "failed"),
+						},
+					},
+				},
+			},
+			"port_checks": hostConfigAttributes["port_checks"],
+			"http_checks": hostConfigAttributes["http_checks"],
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of a config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Id of the config type. Defaults to the id of the `ziti-tunneler-server.v1` config type of the controller",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZitiTunnelerServerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*edge_apis.ManagementApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apis.ManagementApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type TunnelerServerListenOptionsDTO struct {
+	BindUsingEdgeIdentity *bool   `json:"bindUsingEdgeIdentity,omitempty"`
+	ConnectTimeoutSeconds *int32  `json:"connectTimeoutSeconds,omitempty"`
+	Cost                  *int32  `json:"cost,omitempty"`
+	Identity              *string `json:"identity,omitempty"`
+	MaxConnections        *int32  `json:"maxConnections,omitempty"`
+	Precedence            *string `json:"precedence,omitempty"`
+}
+
+type TunnelerServerConfigDTO struct {
+	Hostname      *string                         `json:"hostname,omitempty"`
+	Port          *int32                          `json:"port,omitempty"`
+	Protocol      *string                         `json:"protocol,omitempty"`
+	ListenOptions *TunnelerServerListenOptionsDTO `json:"listenOptions,omitempty"`
+	HTTPChecks    *[]HTTPCheckDTO                 `json:"httpChecks,omitempty"`
+	PortChecks    *[]PortCheckDTO                 `json:"portChecks,omitempty"`
+}
+
+func (dto *TunnelerServerConfigDTO) ConvertToZitiResourceModel(ctx context.Context) ZitiTunnelerServerConfigResourceModel {
+	res := ZitiTunnelerServerConfigResourceModel{
+		Hostname: types.StringPointerValue(dto.Hostname),
+		Port:     types.Int32PointerValue(dto.Port),
+		Protocol: types.StringPointerValue(dto.Protocol),
+	}
+
+	if dto.ListenOptions != nil {
+		listenOptionsObject, _ := JsonStructToObject(ctx, *dto.ListenOptions, true, false)
+		listenOptionsObject = convertKeysToSnake(listenOptionsObject)
+
+		listenOptionsMap := NativeBasicTypedAttributesToTerraform(ctx, listenOptionsObject, TunnelerServerListenOptionsModel.AttrTypes)
+
+		listenOptionsTf, err := basetypes.NewObjectValue(TunnelerServerListenOptionsModel.AttrTypes, listenOptionsMap)
+		if err != nil {
+			oneerr := err[0]
+			tflog.Debug(ctx, "Error converting listenOptionsMap to an object: "+oneerr.Summary()+" | "+oneerr.Detail())
+		}
+		res.ListenOptions = listenOptionsTf
+	} else {
+		res.ListenOptions = types.ObjectNull(TunnelerServerListenOptionsModel.AttrTypes)
+	}
+
+	if dto.HTTPChecks != nil {
+		res.HTTPChecks = convertChecksToTerraformList(ctx, *dto.HTTPChecks, HTTPCheckModel.AttrTypes, HTTPCheckModel)
+	} else {
+		res.HTTPChecks = types.ListNull(HTTPCheckModel)
+	}
+
+	if dto.PortChecks != nil {
+		res.PortChecks = convertChecksToTerraformList(ctx, *dto.PortChecks, PortCheckModel.AttrTypes, PortCheckModel)
+	} else {
+		res.PortChecks = types.ListNull(PortCheckModel)
+	}
+
+	return res
+}
+
+func (r *ZitiTunnelerServerConfigResourceModel) ToTunnelerServerConfigDTO(ctx context.Context) TunnelerServerConfigDTO {
+	listenOptions := AttributesToStruct[TunnelerServerListenOptionsDTO](ctx, r.ListenOptions.Attributes())
+	var portChecks []PortCheckDTO
+	for _, v := range r.PortChecks.Elements() {
+		if v, ok := v.(types.Object); ok {
+			portCheck := AttributesToStruct[PortCheckDTO](ctx, v.Attributes())
+			portChecks = append(portChecks, portCheck)
+		}
+	}
+	var httpChecks []HTTPCheckDTO
+	for _, v := range r.HTTPChecks.Elements() {
+		if v, ok := v.(types.Object); ok {
+			httpCheck := AttributesToStruct[HTTPCheckDTO](ctx, v.Attributes())
+			httpChecks = append(httpChecks, httpCheck)
+		}
+	}
+
+	return TunnelerServerConfigDTO{
+		Hostname:      r.Hostname.ValueStringPointer(),
+		Port:          r.Port.ValueInt32Pointer(),
+		Protocol:      r.Protocol.ValueStringPointer(),
+		ListenOptions: &listenOptions,
+		PortChecks:    &portChecks,
+		HTTPChecks:    &httpChecks,
+	}
+}
+
+func (r *ZitiTunnelerServerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ZitiTunnelerServerConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToTunnelerServerConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	jsonObj, _ := json.Marshal(requestObject)
+	tflog.Debug(ctx, string(jsonObj))
+
+	configTypeId := plan.ConfigTypeId.ValueString()
+	if configTypeId == "" {
+		configTypeId, err = GetConfigTypeIdByName(r.client, "ziti-tunneler-server.v1")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ziti Config Type from API",
+				"Could not find the ziti-tunneler-server.v1 config type: "+err.Error(),
+			)
+			return
+		}
+		plan.ConfigTypeId = types.StringValue(configTypeId)
+	}
+
+	name := plan.Name.ValueString()
+	configCreate := rest_model.ConfigCreate{
+		ConfigTypeID: &configTypeId,
+		Name:         &name,
+		Data:         requestObject,
+	}
+	params := config.NewCreateConfigParams()
+	params.Config = &configCreate
+
+	tflog.Debug(ctx, "Assigned all the params. Making CreateConfig req")
+
+	data, err := r.client.API.Config.CreateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Creating Ziti Config from API",
+			"Could not create Ziti Config "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(data.Payload.Data.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTunnelerServerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ZitiTunnelerServerConfigResourceModel
+
+	tflog.Debug(ctx, "Reading Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDetailConfigParams()
+	params.ID = state.ID.ValueString()
+	data, err := r.client.API.Config.DetailConfig(params, nil)
+	if _, ok := err.(*config.DetailConfigNotFound); ok {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Reading Ziti Config from API",
+			"Could not read Ziti Config ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	responseData, ok := data.Payload.Data.Data.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error casting a response from a ziti controller to a dictionary",
+			"Could not cast a response from ziti to a dictionary",
+		)
+		return
+	}
+
+	var tunnelerServerConfigDto TunnelerServerConfigDTO
+	GenericFromObject(responseData, &tunnelerServerConfigDto)
+	newState := tunnelerServerConfigDto.ConvertToZitiResourceModel(ctx)
+
+	newState.Name = types.StringPointerValue(data.Payload.Data.Name)
+	newState.ID = state.ID
+	newState.ConfigTypeId = types.StringPointerValue(data.Payload.Data.ConfigTypeID)
+	state = newState
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ZitiTunnelerServerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ZitiTunnelerServerConfigResourceModel
+
+	tflog.Debug(ctx, "Updating Ziti config")
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestObject, err := JsonStructToObject(ctx, plan.ToTunnelerServerConfigDTO(ctx), true, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error marshalling Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	configUpdate := rest_model.ConfigUpdate{
+		Name: &name,
+		Data: requestObject,
+	}
+
+	params := config.NewUpdateConfigParams()
+	params.ID = plan.ID.ValueString()
+	params.Config = &configUpdate
+
+	_, err = r.client.API.Config.UpdateConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Updating Ziti Config from API",
+			"Could not update Ziti Config "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ZitiTunnelerServerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ZitiTunnelerServerConfigResourceModel
+
+	tflog.Debug(ctx, "Deleting Ziti config")
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := config.NewDeleteConfigParams()
+	params.ID = state.ID.ValueString()
+
+	_, err := r.client.API.Config.DeleteConfig(params, nil)
+	if err != nil {
+		err = rest_util.WrapErr(err)
+		resp.Diagnostics.AddError(
+			"Error Deleting Ziti Config from API",
+			"Could not delete Ziti Config "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ZitiTunnelerServerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -4,15 +4,24 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 
 	"encoding/json"
+	"github.com/fullsailor/pkcs7"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/iancoleman/strcase"
 	"github.com/openziti/edge-api/rest_model"
@@ -293,3 +302,190 @@ func ElementsToListOfStructsPointers[T any](ctx context.Context, elements []attr
 
 	return result
 }
+
+// ParsePrivateKeyPem parses the first PEM block of keyPem as a PKCS#1, PKCS#8 or EC private key.
+func ParsePrivateKeyPem(keyPem string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(keyPem))
+	if block == nil {
+		return nil, errors.New("no PEM block found in the private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported private key format: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// ParseCertificatesPem parses all the CERTIFICATE PEM blocks of certsPem, eg a leaf certificate followed by its chain.
+func ParseCertificatesPem(certsPem string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(certsPem)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse a certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no CERTIFICATE PEM block found")
+	}
+	return certs, nil
+}
+
+// ParseCaPool parses CA certificates given as a PEM bundle, a PKCS#7 structure or DER, either raw or base64 encoded,
+// into a cert pool. The format is detected from the content.
+func ParseCaPool(caPool []byte) (*x509.CertPool, error) {
+	certs, err := parseCaCertificates(caPool)
+	if err != nil {
+		decoded, decodeErr := base64.StdEncoding.DecodeString(strings.TrimSpace(string(caPool)))
+		if decodeErr != nil {
+			return nil, err
+		}
+		certs, err = parseCaCertificates(decoded)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
+
+func parseCaCertificates(data []byte) ([]*x509.Certificate, error) {
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		var certs []*x509.Certificate
+		rest := data
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			switch block.Type {
+			case "CERTIFICATE":
+				cert, err := x509.ParseCertificate(block.Bytes)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse a certificate: %w", err)
+				}
+				certs = append(certs, cert)
+			case "PKCS7":
+				p7, err := pkcs7.Parse(block.Bytes)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse a PKCS7 block: %w", err)
+				}
+				certs = append(certs, p7.Certificates...)
+			}
+		}
+		if len(certs) == 0 {
+			return nil, errors.New("no CERTIFICATE or PKCS7 PEM block found")
+		}
+		return certs, nil
+	}
+
+	if p7, err := pkcs7.Parse(data); err == nil && len(p7.Certificates) > 0 {
+		return p7.Certificates, nil
+	}
+	if certs, err := x509.ParseCertificates(data); err == nil && len(certs) > 0 {
+		return certs, nil
+	}
+	return nil, errors.New("not a PEM bundle, a PKCS7 structure or DER encoded certificates")
+}
+
+// ZitiQlString quotes a value as a string literal of a ZitiQL filter, escaping it the way JSON strings are.
+func ZitiQlString(value string) string {
+	var quoted bytes.Buffer
+	encoder := json.NewEncoder(&quoted)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string cannot fail.
+	_ = encoder.Encode(value)
+	return strings.TrimSuffix(quoted.String(), "\n")
+}
+
+// DecodeJson decodes a JSON document, keeping its numbers as json.Number so that large integers do not lose precision.
+func DecodeJson(document string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+	return value, nil
+}
+
+// NativeJsonToTerraformValue converts a decoded JSON value into a terraform value, suitable for a dynamic attribute.
+// Objects become objects, arrays become tuples and nulls become null strings.
+func NativeJsonToTerraformValue(ctx context.Context, value interface{}) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), diags
+	case bool:
+		return types.BoolValue(v), diags
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), diags
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			diags.AddError("Unsupported JSON value", "Could not convert the number "+v.String()+": "+err.Error())
+			return types.NumberNull(), diags
+		}
+		return types.NumberValue(number), diags
+	case string:
+		return types.StringValue(v), diags
+	case []interface{}:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			element, d := NativeJsonToTerraformValue(ctx, item)
+			diags.Append(d...)
+			elementTypes = append(elementTypes, element.Type(ctx))
+			elements = append(elements, element)
+		}
+		tuple, d := basetypes.NewTupleValue(elementTypes, elements)
+		diags.Append(d...)
+		return tuple, diags
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			element, d := NativeJsonToTerraformValue(ctx, item)
+			diags.Append(d...)
+			attrTypes[key] = element.Type(ctx)
+			attrs[key] = element
+		}
+		object, d := types.ObjectValue(attrTypes, attrs)
+		diags.Append(d...)
+		return object, diags
+	default:
+		diags.AddError("Unsupported JSON value", fmt.Sprintf("Could not convert a value of type %T", value))
+		return types.StringNull(), diags
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fullsailor/pkcs7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZitiQlString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "plain", value: "host.v1", expected: `"host.v1"`},
+		{name: "empty", value: "", expected: `""`},
+		{name: "quote", value: `a" or name != "b`, expected: `"a\" or name != \"b"`},
+		{name: "backslash", value: `a\b`, expected: `"a\\b"`},
+		{name: "control characters", value: "a\nb\tc\x01", expected: `"a\nb\tc\u0001"`},
+		{name: "html characters are kept", value: "<a&b>", expected: `"<a&b>"`},
+		{name: "unicode is kept", value: "zití", expected: `"zití"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ZitiQlString(test.value))
+		})
+	}
+}
+
+func TestParseCaPool(t *testing.T) {
+	first := testCaCertificate(t, "first")
+	second := testCaCertificate(t, "second")
+	expected := x509.NewCertPool()
+	expected.AddCert(first)
+	expected.AddCert(second)
+
+	firstPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: first.Raw})
+	secondPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: second.Raw})
+	p7, err := pkcs7.DegenerateCertificate(append(append([]byte{}, first.Raw...), second.Raw...))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		caPool string
+	}{
+		{name: "pem bundle", caPool: string(firstPem) + string(secondPem)},
+		{
+			name: "junk between pem blocks",
+			caPool: "# first\n" + string(firstPem) + "\nsubject=CN = second\n" +
+				string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("junk")})) + string(secondPem),
+		},
+		{name: "base64 encoded pem bundle", caPool: base64.StdEncoding.EncodeToString(append(firstPem, secondPem...))},
+		{name: "pkcs7", caPool: string(p7)},
+		{name: "base64 encoded pkcs7", caPool: base64.StdEncoding.EncodeToString(p7)},
+		{name: "pem encoded pkcs7", caPool: string(pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: p7}))},
+		{name: "der", caPool: string(append(append([]byte{}, first.Raw...), second.Raw...))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pool, err := ParseCaPool([]byte(test.caPool))
+			require.NoError(t, err)
+			assert.True(t, expected.Equal(pool))
+		})
+	}
+
+	for name, caPool := range map[string]string{
+		"empty bundle":        "",
+		"blank bundle":        "\n  \n",
+		"no certificate":      string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("junk")})),
+		"invalid certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("junk")})),
+		"not a certificate":   "junk",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCaPool([]byte(caPool))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// testCaCertificate returns a self-signed CA certificate with the given common name.
+func testCaCertificate(t *testing.T, commonName string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
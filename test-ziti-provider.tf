@@ -13,6 +13,7 @@ provider "ziti" {
   username            = "testadmin"
   password        = "testadmin"
   mgmt_endpoint            = "https://localhost:1280/edge/management/v1"
+  trust_well_known_ca = true
 }
 
 resource "ziti_host_config_v1" "simple_host" {